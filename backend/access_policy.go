@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+)
+
+// AccessPolicy holds the visibility rules shared by the REST handlers and the
+// GraphQL @canViewUser and @chatMember directives, so the two APIs cannot
+// drift apart.
+type AccessPolicy interface {
+	// CanViewUser reports whether viewerID may see targetID's profile, bio
+	// and avatar: always their own, otherwise when neither has blocked the
+	// other and they have a pending or accepted connection, or targetID is
+	// currently recommended to viewerID
+	CanViewUser(ctx context.Context, viewerID, targetID int) (bool, error)
+	// IsChatMember reports whether userID is one of the two chat participants
+	IsChatMember(ctx context.Context, userID, chatID int) (bool, error)
+}
+
+type accessPolicy struct {
+	db       *sql.DB
+	profiles UserProfileRepository
+	chats    ChatRepository
+	recs     RecommendationService
+}
+
+func NewAccessPolicy(db *sql.DB) AccessPolicy {
+	return &accessPolicy{
+		db:       db,
+		profiles: NewUserProfileRepository(db),
+		chats:    NewChatRepository(db),
+		recs:     NewRecommendationService(NewRecommendationRepository(db)),
+	}
+}
+
+func (p *accessPolicy) CanViewUser(ctx context.Context, viewerID, targetID int) (bool, error) {
+	if viewerID == targetID {
+		return true, nil
+	}
+	blocked, err := isBlocked(ctx, p.db, viewerID, targetID)
+	if err != nil || blocked {
+		return false, err
+	}
+	connected, err := p.profiles.HasConnection(ctx, viewerID, targetID)
+	if err != nil || connected {
+		return connected, err
+	}
+	return p.recs.IsCurrentlyRecommendable(ctx, viewerID, targetID)
+}
+
+func (p *accessPolicy) IsChatMember(ctx context.Context, userID, chatID int) (bool, error) {
+	return p.chats.IsChatMember(ctx, userID, chatID)
+}
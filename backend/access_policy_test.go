@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"testing"
+)
+
+func TestAccessPolicy(t *testing.T) {
+	alice := createTestUserForConnections(t, "policy_alice@test.com", "password123")
+	bob := createTestUserForConnections(t, "policy_bob@test.com", "password123")
+	carol := createTestUserForConnections(t, "policy_carol@test.com", "password123")
+	defer cleanupConnectionTestData("policy_alice@test.com", "policy_bob@test.com", "policy_carol@test.com")
+
+	ctx := context.Background()
+	policy := NewAccessPolicy(db)
+	canView := func(viewer, target int) bool {
+		t.Helper()
+		ok, err := policy.CanViewUser(ctx, viewer, target)
+		if err != nil {
+			t.Fatalf("CanViewUser failed: %v", err)
+		}
+		return ok
+	}
+
+	if !canView(alice.ID, alice.ID) {
+		t.Fatal("expected users to see themselves")
+	}
+	if canView(alice.ID, bob.ID) {
+		t.Fatal("expected strangers to be hidden")
+	}
+	if _, err := db.Exec(`INSERT INTO connections (user_id, target_user_id, status) VALUES ($1, $2, 'pending')`, alice.ID, bob.ID); err != nil {
+		t.Fatalf("failed to connect users: %v", err)
+	}
+	if !canView(alice.ID, bob.ID) || !canView(bob.ID, alice.ID) {
+		t.Fatal("expected a pending request to make both users visible")
+	}
+	if _, err := db.Exec(`INSERT INTO blocks (blocker_id, blocked_id) VALUES ($1, $2)`, bob.ID, alice.ID); err != nil {
+		t.Fatalf("failed to block: %v", err)
+	}
+	if canView(alice.ID, bob.ID) || canView(bob.ID, alice.ID) {
+		t.Fatal("expected a block to hide both users")
+	}
+
+	if _, err := db.Exec(`INSERT INTO connections (user_id, target_user_id, status) VALUES ($1, $2, 'accepted')`, alice.ID, carol.ID); err != nil {
+		t.Fatalf("failed to connect users: %v", err)
+	}
+	_, chatID, _, err := saveChatMsg(ctx, db, alice.ID, carol.ID, "hi")
+	if err != nil {
+		t.Fatalf("failed to send message: %v", err)
+	}
+	for _, c := range []struct {
+		user   int
+		member bool
+	}{{alice.ID, true}, {carol.ID, true}, {bob.ID, false}} {
+		member, err := policy.IsChatMember(ctx, c.user, chatID)
+		if err != nil || member != c.member {
+			t.Fatalf("IsChatMember(%d) = %v %v, want %v", c.user, member, err, c.member)
+		}
+	}
+}
@@ -0,0 +1,187 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"gitea.kood.tech/petrkubec/match-me/backend/graph/model"
+)
+
+// The admin API is for the ops team; every route requires the admin role.
+
+// writeAdminError maps admin errors to responses
+func writeAdminError(w http.ResponseWriter, where string, err error) {
+	switch {
+	case errors.Is(err, ErrInvalidState):
+		writeError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, ErrInvalidRole):
+		writeError(w, http.StatusBadRequest, err.Error())
+	default:
+		writeModerationError(w, where, err)
+	}
+}
+
+// GET /admin/stats - Site-wide counters
+func adminStatsHandler(db *sql.DB) http.HandlerFunc {
+	svc := NewAdminService(db, NewAdminRepository(db))
+	return requireRole(roleAdmin, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			writeError(w, http.StatusMethodNotAllowed, "invalid_method")
+			return
+		}
+		stats, err := svc.GetStats(r.Context())
+		if err != nil {
+			writeAdminError(w, "adminStatsHandler", err)
+			return
+		}
+		writeJSON(w, http.StatusOK, stats)
+	})
+}
+
+// GET /admin/users?email= - Looks an account up by email
+// GET /admin/users/{id} - Looks an account up by id
+// POST /admin/users/{id}/actions - Applies an account action
+func adminUsersRouter(db *sql.DB) http.HandlerFunc {
+	svc := NewAdminService(db, NewAdminRepository(db))
+	return requireRole(roleAdmin, func(w http.ResponseWriter, r *http.Request) {
+		parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+		if len(parts) < 2 || len(parts) > 4 || parts[0] != "admin" || parts[1] != "users" {
+			http.NotFound(w, r)
+			return
+		}
+
+		if len(parts) == 2 {
+			if r.Method != http.MethodGet {
+				writeError(w, http.StatusMethodNotAllowed, "invalid_method")
+				return
+			}
+			email := r.URL.Query().Get("email")
+			if strings.TrimSpace(email) == "" {
+				writeError(w, http.StatusBadRequest, "missing_query")
+				return
+			}
+			user, err := svc.LookupEmail(r.Context(), email)
+			if err != nil {
+				writeAdminError(w, "adminUsersRouter", err)
+				return
+			}
+			writeJSON(w, http.StatusOK, user)
+			return
+		}
+
+		id, err := strconv.Atoi(parts[2])
+		if err != nil {
+			writeError(w, http.StatusNotFound, "not_found")
+			return
+		}
+
+		switch {
+		case len(parts) == 3:
+			if r.Method != http.MethodGet {
+				writeError(w, http.StatusMethodNotAllowed, "invalid_method")
+				return
+			}
+			user, err := svc.LookupUser(r.Context(), id)
+			if err != nil {
+				writeAdminError(w, "adminUsersRouter", err)
+				return
+			}
+			writeJSON(w, http.StatusOK, user)
+
+		case parts[3] == "actions":
+			if r.Method != http.MethodPost {
+				writeError(w, http.StatusMethodNotAllowed, "invalid_method")
+				return
+			}
+			var req struct {
+				Action string `json:"action"`
+				Role   string `json:"role"`
+				Reason string `json:"reason"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				writeError(w, http.StatusBadRequest, "invalid_json")
+				return
+			}
+			me := r.Context().Value(userIDKey).(int)
+			action, err := svc.AccountAction(r.Context(), me, id, req.Action, req.Role, req.Reason)
+			if err != nil {
+				writeAdminError(w, "adminUsersRouter", err)
+				return
+			}
+			writeJSON(w, http.StatusCreated, action)
+
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// graphAdminService adapts AdminService to the GraphQL layer
+type graphAdminService struct {
+	AdminService
+}
+
+func (g graphAdminService) Stats(ctx context.Context) (*model.AdminStats, error) {
+	s, err := g.GetStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &model.AdminStats{
+		Users:            s.Users,
+		CompleteProfiles: s.CompleteProfiles,
+		ActiveUsers:      s.ActiveUsers,
+		NewUsers:         s.NewUsers,
+		Moderators:       s.Moderators,
+		Admins:           s.Admins,
+		BannedUsers:      s.BannedUsers,
+		SuspendedUsers:   s.SuspendedUsers,
+		OpenReports:      s.OpenReports,
+		Connections:      s.Connections,
+		MessagesLastDay:  s.MessagesLastDay,
+	}, nil
+}
+
+func (g graphAdminService) User(ctx context.Context, userID int, email string) (*model.AdminUser, error) {
+	var u AdminUser
+	var err error
+	if userID != 0 {
+		u, err = g.LookupUser(ctx, userID)
+	} else {
+		u, err = g.LookupEmail(ctx, email)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &model.AdminUser{
+		ID:              strconv.Itoa(u.ID),
+		Email:           u.Email,
+		Role:            model.Role(strings.ToUpper(u.Role)),
+		CreatedAt:       u.CreatedAt.Format(time.RFC3339),
+		LastOnline:      formatTimePtr(u.LastOnline),
+		BannedAt:        formatTimePtr(u.BannedAt),
+		SuspendedUntil:  formatTimePtr(u.SuspendedUntil),
+		DisplayName:     u.DisplayName,
+		ProfileComplete: u.ProfileComplete,
+		OpenReports:     u.OpenReports,
+		ReportsFiled:    u.ReportsFiled,
+	}, nil
+}
+
+func (g graphAdminService) SetRole(ctx context.Context, adminID, userID int, role, reason string) error {
+	_, err := g.AccountAction(ctx, adminID, userID, "set_role", role, reason)
+	return err
+}
+
+func formatTimePtr(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := t.Format(time.RFC3339)
+	return &s
+}
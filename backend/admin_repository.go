@@ -0,0 +1,135 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+)
+
+// AdminRepository defines the data access methods behind the admin API
+type AdminRepository interface {
+	GetUser(ctx context.Context, userID int) (AdminUser, error)
+	FindUserID(ctx context.Context, email string) (int, error)
+	GetUserActions(ctx context.Context, userID int) ([]ModerationAction, error)
+	GetStats(ctx context.Context) (AdminStats, error)
+
+	// Used inside AccountAction's transaction; false means nothing changed
+	SetRole(ctx context.Context, tx *sql.Tx, userID int, role string) (bool, error)
+	LiftBan(ctx context.Context, tx *sql.Tx, userID int) (bool, error)
+	LiftSuspension(ctx context.Context, tx *sql.Tx, userID int) (bool, error)
+}
+
+type sqlAdminRepo struct {
+	db *sql.DB
+}
+
+func NewAdminRepository(db *sql.DB) AdminRepository {
+	return &sqlAdminRepo{db: db}
+}
+
+func (r *sqlAdminRepo) GetUser(ctx context.Context, userID int) (AdminUser, error) {
+	var u AdminUser
+	err := r.db.QueryRowContext(ctx, `
+		SELECT u.id, u.email, u.role, u.created_at, u.last_online, u.banned_at, u.suspended_until,
+		       p.display_name, COALESCE(p.is_complete, FALSE),
+		       (SELECT COUNT(*) FROM reports WHERE reported_user_id = u.id AND status = 'open'),
+		       (SELECT COUNT(*) FROM reports WHERE reporter_id = u.id)
+		FROM users u
+		LEFT JOIN profiles p ON p.user_id = u.id
+		WHERE u.id = $1
+	`, userID).Scan(&u.ID, &u.Email, &u.Role, &u.CreatedAt, &u.LastOnline, &u.BannedAt, &u.SuspendedUntil,
+		&u.DisplayName, &u.ProfileComplete, &u.OpenReports, &u.ReportsFiled)
+	if err == sql.ErrNoRows {
+		return AdminUser{}, ErrNotFound
+	}
+	return u, err
+}
+
+func (r *sqlAdminRepo) FindUserID(ctx context.Context, email string) (int, error) {
+	var id int
+	err := r.db.QueryRowContext(ctx, `SELECT id FROM users WHERE lower(email) = lower($1)`, email).Scan(&id)
+	if err == sql.ErrNoRows {
+		return 0, ErrNotFound
+	}
+	return id, err
+}
+
+// GetUserActions lists every moderation and account action on the user, most
+// recent first
+func (r *sqlAdminRepo) GetUserActions(ctx context.Context, userID int) ([]ModerationAction, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, user_id, COALESCE(moderator_id, 0), report_id, action, reason, until, COALESCE(role, ''), created_at
+		FROM moderation_actions
+		WHERE user_id = $1
+		ORDER BY created_at DESC, id DESC
+	`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	actions := []ModerationAction{}
+	for rows.Next() {
+		var a ModerationAction
+		if err := rows.Scan(&a.ID, &a.UserID, &a.ModeratorID, &a.ReportID, &a.Action, &a.Reason, &a.Until, &a.Role, &a.CreatedAt); err != nil {
+			return nil, err
+		}
+		actions = append(actions, a)
+	}
+	return actions, rows.Err()
+}
+
+func (r *sqlAdminRepo) GetStats(ctx context.Context) (AdminStats, error) {
+	var s AdminStats
+	err := r.db.QueryRowContext(ctx, `
+		SELECT
+			(SELECT COUNT(*) FROM users),
+			(SELECT COUNT(*) FROM profiles WHERE is_complete),
+			(SELECT COUNT(*) FROM users WHERE last_online > NOW() - INTERVAL '15 minutes'),
+			(SELECT COUNT(*) FROM users WHERE created_at > NOW() - INTERVAL '1 day'),
+			(SELECT COUNT(*) FROM users WHERE role = 'moderator'),
+			(SELECT COUNT(*) FROM users WHERE role = 'admin'),
+			(SELECT COUNT(*) FROM users WHERE banned_at IS NOT NULL),
+			(SELECT COUNT(*) FROM users WHERE banned_at IS NULL AND suspended_until > NOW()),
+			(SELECT COUNT(*) FROM reports WHERE status = 'open'),
+			(SELECT COUNT(*) FROM connections WHERE status = 'accepted'),
+			(SELECT COUNT(*) FROM messages WHERE created_at > NOW() - INTERVAL '1 day')
+	`).Scan(&s.Users, &s.CompleteProfiles, &s.ActiveUsers, &s.NewUsers, &s.Moderators, &s.Admins,
+		&s.BannedUsers, &s.SuspendedUsers, &s.OpenReports, &s.Connections, &s.MessagesLastDay)
+	return s, err
+}
+
+// SetRole changes the user's role and bumps their token version, so tokens
+// carrying the old role claim stop working. Refresh tokens stay valid and get
+// the new role on the next refresh.
+func (r *sqlAdminRepo) SetRole(ctx context.Context, tx *sql.Tx, userID int, role string) (bool, error) {
+	res, err := tx.ExecContext(ctx, `
+		UPDATE users SET role = $2, token_version = token_version + 1
+		WHERE id = $1 AND role <> $2
+	`, userID, role)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
+
+func (r *sqlAdminRepo) LiftBan(ctx context.Context, tx *sql.Tx, userID int) (bool, error) {
+	res, err := tx.ExecContext(ctx, `UPDATE users SET banned_at = NULL WHERE id = $1 AND banned_at IS NOT NULL`, userID)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
+
+func (r *sqlAdminRepo) LiftSuspension(ctx context.Context, tx *sql.Tx, userID int) (bool, error) {
+	res, err := tx.ExecContext(ctx, `
+		UPDATE users SET suspended_until = NULL
+		WHERE id = $1 AND suspended_until > NOW()
+	`, userID)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
@@ -0,0 +1,156 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"gitea.kood.tech/petrkubec/match-me/backend/events"
+)
+
+// ErrInvalidRole is returned for a role name other than user, moderator or admin
+var ErrInvalidRole = errors.New("invalid_role")
+
+// AdminUser is an account as the admin API shows it
+type AdminUser struct {
+	ID              int                `json:"id"`
+	Email           string             `json:"email"`
+	Role            string             `json:"role"`
+	CreatedAt       time.Time          `json:"created_at"`
+	LastOnline      *time.Time         `json:"last_online,omitempty"`
+	BannedAt        *time.Time         `json:"banned_at,omitempty"`
+	SuspendedUntil  *time.Time         `json:"suspended_until,omitempty"`
+	DisplayName     *string            `json:"display_name,omitempty"`
+	ProfileComplete bool               `json:"profile_complete"`
+	OpenReports     int                `json:"open_reports"`  // open reports against the user
+	ReportsFiled    int                `json:"reports_filed"` // reports the user filed
+	Actions         []ModerationAction `json:"actions"`       // most recent first
+}
+
+// AdminStats are site-wide counters for the ops team
+type AdminStats struct {
+	Users            int `json:"users"`
+	CompleteProfiles int `json:"complete_profiles"`
+	ActiveUsers      int `json:"active_users"` // seen in the last 15 minutes
+	NewUsers         int `json:"new_users"`    // registered in the last day
+	Moderators       int `json:"moderators"`
+	Admins           int `json:"admins"`
+	BannedUsers      int `json:"banned_users"`
+	SuspendedUsers   int `json:"suspended_users"`
+	OpenReports      int `json:"open_reports"`
+	Connections      int `json:"connections"` // accepted
+	MessagesLastDay  int `json:"messages_last_day"`
+}
+
+// AdminService backs the admin API: account lookup, stats and account actions
+type AdminService interface {
+	LookupUser(ctx context.Context, userID int) (AdminUser, error)
+	LookupEmail(ctx context.Context, email string) (AdminUser, error)
+	GetStats(ctx context.Context) (AdminStats, error)
+	AccountAction(ctx context.Context, adminID, userID int, action, role, reason string) (ModerationAction, error)
+}
+
+type adminService struct {
+	db   *sql.DB
+	repo AdminRepository
+	mod  ModerationRepository
+}
+
+func NewAdminService(db *sql.DB, repo AdminRepository) AdminService {
+	return &adminService{
+		db:   db,
+		repo: repo,
+		mod:  NewModerationRepository(db),
+	}
+}
+
+// LookupUser returns the account with its moderation history
+func (s *adminService) LookupUser(ctx context.Context, userID int) (AdminUser, error) {
+	u, err := s.repo.GetUser(ctx, userID)
+	if err != nil {
+		return AdminUser{}, err
+	}
+	if u.Actions, err = s.repo.GetUserActions(ctx, userID); err != nil {
+		return AdminUser{}, err
+	}
+	return u, nil
+}
+
+// LookupEmail is LookupUser by email address, ignoring case
+func (s *adminService) LookupEmail(ctx context.Context, email string) (AdminUser, error) {
+	id, err := s.repo.FindUserID(ctx, strings.TrimSpace(email))
+	if err != nil {
+		return AdminUser{}, err
+	}
+	return s.LookupUser(ctx, id)
+}
+
+func (s *adminService) GetStats(ctx context.Context) (AdminStats, error) {
+	return s.repo.GetStats(ctx)
+}
+
+// AccountAction applies an admin action to userID and records it with the
+// moderation actions:
+//
+//	set_role    change the role (role), which ends the user's access tokens
+//	unban       lift a ban
+//	unsuspend   end a running suspension
+//	logout_all  revoke all the user's tokens
+//
+// An action that would change nothing fails with ErrInvalidState. Admins
+// cannot act on themselves, so the last admin cannot lock everyone out.
+func (s *adminService) AccountAction(ctx context.Context, adminID, userID int, action, role, reason string) (ModerationAction, error) {
+	if adminID == userID {
+		return ModerationAction{}, ErrInvalidTarget
+	}
+	reason, err := cleanReason(reason)
+	if err != nil {
+		return ModerationAction{}, err
+	}
+	a := ModerationAction{UserID: userID, ModeratorID: adminID, Action: action, Reason: reason}
+	switch action {
+	case "set_role":
+		if !validRole(role) {
+			return ModerationAction{}, ErrInvalidRole
+		}
+		a.Role = role
+	case "unban", "unsuspend", "logout_all":
+	default:
+		return ModerationAction{}, ErrInvalidAction
+	}
+	if _, err := s.mod.GetUserRole(ctx, userID); err != nil {
+		return ModerationAction{}, err
+	}
+
+	err = withTx(ctx, s.db, func(tx *sql.Tx) error {
+		changed := true
+		var err error
+		switch action {
+		case "set_role":
+			changed, err = s.repo.SetRole(ctx, tx, userID, role)
+		case "unban":
+			changed, err = s.repo.LiftBan(ctx, tx, userID)
+		case "unsuspend":
+			changed, err = s.repo.LiftSuspension(ctx, tx, userID)
+		case "logout_all":
+			err = revokeAllTokensTx(ctx, tx, userID)
+		}
+		if err != nil {
+			return err
+		}
+		if !changed {
+			return ErrInvalidState
+		}
+		return s.mod.InsertAction(ctx, tx, &a)
+	})
+	if err != nil {
+		return ModerationAction{}, err
+	}
+
+	if action == "unban" || action == "unsuspend" {
+		events.Default().PublishAccount(events.Account{UserID: userID, Status: accountActive})
+	}
+	return a, nil
+}
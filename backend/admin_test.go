@@ -0,0 +1,48 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestAdminAPI(t *testing.T) {
+	admin := createTestUserForConnections(t, "admin_root@test.com", "password123")
+	alice := createTestUserForConnections(t, "admin_alice@test.com", "password123")
+	defer cleanupConnectionTestData("admin_root@test.com", "admin_alice@test.com")
+
+	admin.Token = promoteTestUser(t, admin, "admin_root@test.com", roleAdmin)
+
+	if w := authedCall(alice.Token, http.MethodGet, "/admin/stats", nil, adminStatsHandler(db)); w.Code != http.StatusForbidden {
+		t.Fatalf("expected users to be kept out of the admin API, got %d", w.Code)
+	}
+	if w := authedCall(admin.Token, http.MethodGet, "/admin/stats", nil, adminStatsHandler(db)); w.Code != http.StatusOK {
+		t.Fatalf("expected stats, got %d: %s", w.Code, w.Body.String())
+	}
+
+	w := authedCall(admin.Token, http.MethodGet, "/admin/users?email=ADMIN_ALICE@test.com", nil, adminUsersRouter(db))
+	var user AdminUser
+	json.Unmarshal(w.Body.Bytes(), &user)
+	if w.Code != http.StatusOK || user.ID != alice.ID || user.Role != roleUser {
+		t.Fatalf("expected to find alice by email, got %d: %s", w.Code, w.Body.String())
+	}
+
+	promote := map[string]string{"action": "set_role", "role": roleModerator, "reason": "joins the team"}
+	path := fmt.Sprintf("/admin/users/%d/actions", alice.ID)
+	if w := authedCall(admin.Token, http.MethodPost, path, promote, adminUsersRouter(db)); w.Code != http.StatusCreated {
+		t.Fatalf("expected the role change to succeed, got %d: %s", w.Code, w.Body.String())
+	}
+	if w := authedCall(admin.Token, http.MethodPost, path, promote, adminUsersRouter(db)); w.Code != http.StatusConflict {
+		t.Fatalf("expected a no-op role change to be rejected, got %d", w.Code)
+	}
+	if w := authedCall(alice.Token, http.MethodGet, "/moderation/reports", nil, moderationQueueHandler(db)); w.Code != http.StatusUnauthorized {
+		t.Fatalf("expected the token with the old role to be revoked, got %d", w.Code)
+	}
+
+	w = authedCall(admin.Token, http.MethodGet, fmt.Sprintf("/admin/users/%d", alice.ID), nil, adminUsersRouter(db))
+	json.Unmarshal(w.Body.Bytes(), &user)
+	if user.Role != roleModerator || len(user.Actions) != 1 || user.Actions[0].Role != roleModerator {
+		t.Fatalf("expected the audited role change, got %s", w.Body.String())
+	}
+}
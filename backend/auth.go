@@ -19,6 +19,9 @@ const UserIDKeyValue UserIDKey = "userID"
 // For backward compatibility and local usage
 const userIDKey = UserIDKeyValue
 
+// userRoleKey holds the role claim of the authenticated user's token
+const userRoleKey UserIDKey = "userRole"
+
 func registerHandler(db *sql.DB) http.HandlerFunc {
 	repo := NewAuthRepository(db)
 	svc := NewAuthService(repo)
@@ -55,7 +58,19 @@ func registerHandler(db *sql.DB) http.HandlerFunc {
 			return
 		}
 
-		writeJSON(w, http.StatusCreated, map[string]interface{}{"token": tokenString, "id": newID})
+		refreshToken, err := svc.IssueRefreshToken(r.Context(), newID)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "register_error")
+			log.Println("Error issuing refresh token:", err)
+			return
+		}
+
+		writeJSON(w, http.StatusCreated, map[string]interface{}{
+			"token":         tokenString,
+			"refresh_token": refreshToken,
+			"expires_in":    int(tokenTTL.Seconds()),
+			"id":            newID,
+		})
 	}
 }
 
@@ -90,12 +105,81 @@ func loginHandler(db *sql.DB) http.HandlerFunc {
 				writeError(w, http.StatusUnauthorized, "invalid_credentials")
 				return
 			}
+			if rl, ok := asRateLimitError(err); ok {
+				writeRateLimited(w, rl)
+				return
+			}
+			if errors.Is(err, ErrAccountBanned) || errors.Is(err, ErrAccountSuspended) {
+				writeError(w, http.StatusForbidden, err.Error())
+				return
+			}
 			writeError(w, http.StatusInternalServerError, "login_error")
 			log.Println("Error logging in:", err)
 			return
 		}
 
-		writeJSON(w, http.StatusOK, map[string]interface{}{"token": tokenString, "id": userID})
+		refreshToken, err := svc.IssueRefreshToken(r.Context(), userID)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "login_error")
+			log.Println("Error issuing refresh token:", err)
+			return
+		}
+
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"token":         tokenString,
+			"refresh_token": refreshToken,
+			"expires_in":    int(tokenTTL.Seconds()),
+			"id":            userID,
+		})
+	}
+}
+
+// POST /token/refresh — exchanges a refresh token for a new token pair
+func tokenRefreshHandler(db *sql.DB) http.HandlerFunc {
+	repo := NewAuthRepository(db)
+	svc := NewAuthService(repo)
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			writeError(w, http.StatusMethodNotAllowed, "invalid_method")
+			return
+		}
+
+		type RefreshRequest struct {
+			RefreshToken string `json:"refresh_token"`
+		}
+
+		var req RefreshRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, http.StatusBadRequest, "invalid_json")
+			return
+		}
+
+		tokenString, refreshToken, userID, err := svc.Refresh(r.Context(), req.RefreshToken)
+		if err != nil {
+			if err.Error() == "missing_fields" {
+				writeError(w, http.StatusBadRequest, "missing_fields")
+				return
+			}
+			if errors.Is(err, ErrInvalidRefresh) || errors.Is(err, ErrRefreshReused) {
+				writeError(w, http.StatusUnauthorized, err.Error())
+				return
+			}
+			if errors.Is(err, ErrAccountBanned) || errors.Is(err, ErrAccountSuspended) {
+				writeError(w, http.StatusForbidden, err.Error())
+				return
+			}
+			writeError(w, http.StatusInternalServerError, "refresh_error")
+			log.Println("Error refreshing token:", err)
+			return
+		}
+
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"token":         tokenString,
+			"refresh_token": refreshToken,
+			"expires_in":    int(tokenTTL.Seconds()),
+			"id":            userID,
+		})
 	}
 }
 
@@ -111,9 +195,13 @@ func authenticate(next http.HandlerFunc) http.HandlerFunc {
 		repo := NewAuthRepository(db)
 		svc := NewAuthService(repo)
 
-		userID, err := svc.ValidateToken(tokenStr)
+		userID, role, err := svc.ValidateTokenRole(r.Context(), tokenStr)
 		if err != nil {
-			if err.Error() == "invalid_token_claims" || err.Error() == "invalid_user_id_in_token" || err.Error() == "invalid_token" {
+			if errors.Is(err, ErrAccountBanned) || errors.Is(err, ErrAccountSuspended) {
+				writeError(w, http.StatusForbidden, err.Error())
+				return
+			}
+			if err.Error() == "invalid_token_claims" || err.Error() == "invalid_user_id_in_token" || err.Error() == "invalid_token" || errors.Is(err, ErrTokenRevoked) {
 				writeError(w, http.StatusUnauthorized, err.Error())
 				return
 			}
@@ -127,6 +215,82 @@ func authenticate(next http.HandlerFunc) http.HandlerFunc {
 			log.Println("Failed to update last_online:", err)
 		}
 
-		next(w, r.WithContext(context.WithValue(r.Context(), userIDKey, userID)))
+		ctx := context.WithValue(r.Context(), userIDKey, userID)
+		ctx = context.WithValue(ctx, userRoleKey, role)
+		next(w, r.WithContext(ctx))
 	}
 }
+
+// requireRole authenticates the request and lets through only users whose
+// token carries min or a higher role; others get 403
+func requireRole(min string, next http.HandlerFunc) http.HandlerFunc {
+	return authenticate(func(w http.ResponseWriter, r *http.Request) {
+		role, _ := r.Context().Value(userRoleKey).(string)
+		if !roleAtLeast(role, min) {
+			writeError(w, http.StatusForbidden, "forbidden")
+			return
+		}
+		next(w, r)
+	})
+}
+
+// POST /logout — revokes the token used for this request, and the session's
+// refresh token when one is sent as {"refresh_token": "..."}
+func logoutHandler(db *sql.DB) http.HandlerFunc {
+	repo := NewAuthRepository(db)
+	svc := NewAuthService(repo)
+
+	return authenticate(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			writeError(w, http.StatusMethodNotAllowed, "invalid_method")
+			return
+		}
+		tokenStr := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+
+		// Optional body: the refresh token of this session, revoked as well
+		var req struct {
+			RefreshToken string `json:"refresh_token"`
+		}
+		if r.ContentLength != 0 {
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				writeError(w, http.StatusBadRequest, "invalid_json")
+				return
+			}
+		}
+
+		if err := svc.Logout(r.Context(), tokenStr); err != nil {
+			writeError(w, http.StatusInternalServerError, "logout_error")
+			log.Println("Error logging out:", err)
+			return
+		}
+		if req.RefreshToken != "" {
+			if err := svc.RevokeRefreshToken(r.Context(), req.RefreshToken); err != nil {
+				writeError(w, http.StatusInternalServerError, "logout_error")
+				log.Println("Error revoking refresh token:", err)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// POST /logout/all — revokes every token issued to the current user
+func logoutAllHandler(db *sql.DB) http.HandlerFunc {
+	repo := NewAuthRepository(db)
+	svc := NewAuthService(repo)
+
+	return authenticate(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			writeError(w, http.StatusMethodNotAllowed, "invalid_method")
+			return
+		}
+		userID := r.Context().Value(userIDKey).(int)
+
+		if err := svc.LogoutAll(r.Context(), userID); err != nil {
+			writeError(w, http.StatusInternalServerError, "logout_error")
+			log.Println("Error logging out all sessions:", err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"time"
 
 	"github.com/lib/pq"
 )
@@ -16,6 +17,20 @@ type AuthRepository interface {
 	CreateUser(ctx context.Context, email, passwordHash string) (int, error)
 	GetUserByEmail(ctx context.Context, email string) (int, string, error)
 	UpdateLastOnline(ctx context.Context, userID int) error
+	GetAccountStatus(ctx context.Context, userID int) (string, error)
+	GetRole(ctx context.Context, userID int) (string, error)
+
+	// Token revocation store
+	GetTokenVersion(ctx context.Context, userID int) (int, error)
+	RevokeToken(ctx context.Context, jti string, userID int, expiresAt time.Time) error
+	RevokeAllTokens(ctx context.Context, userID int) error
+	IsTokenRevoked(ctx context.Context, jti string, userID, version int) (bool, error)
+
+	// Refresh tokens (stored as SHA-256 hashes)
+	CreateRefreshToken(ctx context.Context, userID int, tokenHash, familyID string, expiresAt time.Time) error
+	RotateRefreshToken(ctx context.Context, oldHash, newHash string, expiresAt time.Time) (int, string, error)
+	RevokeRefreshFamily(ctx context.Context, familyID string) error
+	RevokeRefreshToken(ctx context.Context, tokenHash string) error
 }
 
 type sqlAuthRepo struct {
@@ -56,3 +71,159 @@ func (r *sqlAuthRepo) UpdateLastOnline(ctx context.Context, userID int) error {
 	_, err := r.db.ExecContext(ctx, "UPDATE users SET last_online = NOW() WHERE id = $1", userID)
 	return err
 }
+
+// Account states returned by GetAccountStatus
+const (
+	accountActive    = "active"
+	accountSuspended = "suspended"
+	accountBanned    = "banned"
+)
+
+// GetAccountStatus reports whether the user is active, banned or suspended
+// right now. A ban outranks a suspension; unknown users count as active.
+func (r *sqlAuthRepo) GetAccountStatus(ctx context.Context, userID int) (string, error) {
+	var status string
+	err := r.db.QueryRowContext(ctx, `
+		SELECT COALESCE((
+			SELECT CASE
+				WHEN banned_at IS NOT NULL THEN 'banned'
+				WHEN suspended_until > NOW() THEN 'suspended'
+			END
+			FROM users WHERE id = $1
+		), 'active')
+	`, userID).Scan(&status)
+	return status, err
+}
+
+func (r *sqlAuthRepo) GetRole(ctx context.Context, userID int) (string, error) {
+	var role string
+	err := r.db.QueryRowContext(ctx, "SELECT role FROM users WHERE id = $1", userID).Scan(&role)
+	if err == sql.ErrNoRows {
+		return "", ErrNotFound
+	}
+	return role, err
+}
+
+func (r *sqlAuthRepo) GetTokenVersion(ctx context.Context, userID int) (int, error) {
+	var version int
+	err := r.db.QueryRowContext(ctx, "SELECT token_version FROM users WHERE id = $1", userID).Scan(&version)
+	if err == sql.ErrNoRows {
+		return 0, ErrNotFound
+	}
+	return version, err
+}
+
+func (r *sqlAuthRepo) RevokeToken(ctx context.Context, jti string, userID int, expiresAt time.Time) error {
+	return withTx(ctx, r.db, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO revoked_tokens (jti, user_id, expires_at)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (jti) DO NOTHING
+		`, jti, userID, expiresAt); err != nil {
+			return err
+		}
+		// Expired tokens are rejected anyway; keep the table small
+		_, err := tx.ExecContext(ctx, "DELETE FROM revoked_tokens WHERE expires_at < NOW()")
+		return err
+	})
+}
+
+// RevokeAllTokens bumps the user's token version, which invalidates every
+// access token minted with an older one, and revokes all refresh tokens
+func (r *sqlAuthRepo) RevokeAllTokens(ctx context.Context, userID int) error {
+	return withTx(ctx, r.db, func(tx *sql.Tx) error {
+		return revokeAllTokensTx(ctx, tx, userID)
+	})
+}
+
+// revokeAllTokensTx is RevokeAllTokens inside the caller's transaction
+func revokeAllTokensTx(ctx context.Context, tx *sql.Tx, userID int) error {
+	if _, err := tx.ExecContext(ctx, "UPDATE users SET token_version = token_version + 1 WHERE id = $1", userID); err != nil {
+		return err
+	}
+	_, err := tx.ExecContext(ctx, `
+		UPDATE refresh_tokens SET revoked_at = NOW()
+		WHERE user_id = $1 AND revoked_at IS NULL
+	`, userID)
+	return err
+}
+
+func (r *sqlAuthRepo) IsTokenRevoked(ctx context.Context, jti string, userID, version int) (bool, error) {
+	var revoked bool
+	err := r.db.QueryRowContext(ctx, `
+		SELECT EXISTS (SELECT 1 FROM revoked_tokens WHERE jti = $1 AND $1 <> '')
+		    OR COALESCE((SELECT token_version > $3 FROM users WHERE id = $2), FALSE)
+	`, jti, userID, version).Scan(&revoked)
+	return revoked, err
+}
+
+func (r *sqlAuthRepo) CreateRefreshToken(ctx context.Context, userID int, tokenHash, familyID string, expiresAt time.Time) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO refresh_tokens (user_id, token_hash, family_id, expires_at)
+		VALUES ($1, $2, $3, $4)
+	`, userID, tokenHash, familyID, expiresAt)
+	return err
+}
+
+// RotateRefreshToken marks the old token as used and stores its successor in
+// the same family. It returns ErrNotFound for unknown, expired or revoked
+// tokens and ErrRefreshReused (with the family ID) for already used ones.
+func (r *sqlAuthRepo) RotateRefreshToken(ctx context.Context, oldHash, newHash string, expiresAt time.Time) (int, string, error) {
+	var (
+		userID    int
+		familyID  string
+		expiresOn time.Time
+		usedAt    sql.NullTime
+		revokedAt sql.NullTime
+	)
+	err := withTx(ctx, r.db, func(tx *sql.Tx) error {
+		err := tx.QueryRowContext(ctx, `
+			SELECT user_id, family_id, expires_at, used_at, revoked_at
+			FROM refresh_tokens
+			WHERE token_hash = $1
+			FOR UPDATE
+		`, oldHash).Scan(&userID, &familyID, &expiresOn, &usedAt, &revokedAt)
+		if err == sql.ErrNoRows {
+			return ErrNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if revokedAt.Valid || !expiresOn.After(time.Now()) {
+			return ErrNotFound
+		}
+		if usedAt.Valid {
+			return ErrRefreshReused
+		}
+
+		if _, err := tx.ExecContext(ctx, "UPDATE refresh_tokens SET used_at = NOW() WHERE token_hash = $1", oldHash); err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO refresh_tokens (user_id, token_hash, family_id, expires_at)
+			VALUES ($1, $2, $3, $4)
+		`, userID, newHash, familyID, expiresAt)
+		return err
+	})
+	if err != nil {
+		return 0, familyID, err
+	}
+	return userID, familyID, nil
+}
+
+func (r *sqlAuthRepo) RevokeRefreshFamily(ctx context.Context, familyID string) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE refresh_tokens SET revoked_at = NOW()
+		WHERE family_id = $1 AND revoked_at IS NULL
+	`, familyID)
+	return err
+}
+
+func (r *sqlAuthRepo) RevokeRefreshToken(ctx context.Context, tokenHash string) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE refresh_tokens SET revoked_at = NOW()
+		WHERE family_id = (SELECT family_id FROM refresh_tokens WHERE token_hash = $1)
+		  AND revoked_at IS NULL
+	`, tokenHash)
+	return err
+}
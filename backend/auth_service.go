@@ -2,6 +2,9 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"strings"
 	"time"
@@ -12,21 +15,76 @@ import (
 
 var (
 	ErrInvalidCredentials = errors.New("invalid_credentials")
+	ErrTokenRevoked       = errors.New("token_revoked")
+	ErrInvalidRefresh     = errors.New("invalid_refresh_token")
+	ErrRefreshReused      = errors.New("refresh_token_reused")
+	ErrAccountBanned      = errors.New("account_banned")
+	ErrAccountSuspended   = errors.New("account_suspended")
+)
+
+const (
+	// tokenTTL is the lifetime of an access token. Clients renew it with
+	// their refresh token instead of logging in again.
+	tokenTTL = 15 * time.Minute
+	// refreshTokenTTL is the lifetime of a refresh token; every rotation
+	// starts a new one.
+	refreshTokenTTL = 30 * 24 * time.Hour
 )
 
 type AuthService interface {
 	Register(ctx context.Context, email, password string) (string, int, error)
 	Login(ctx context.Context, email, password string) (string, int, error)
-	ValidateToken(tokenStr string) (int, error)
+	ValidateToken(ctx context.Context, tokenStr string) (int, error)
+	ValidateTokenRole(ctx context.Context, tokenStr string) (int, string, error)
 	UpdateLastOnline(ctx context.Context, userID int) error
+	Logout(ctx context.Context, tokenStr string) error
+	LogoutAll(ctx context.Context, userID int) error
+
+	// Refresh tokens
+	IssueRefreshToken(ctx context.Context, userID int) (string, error)
+	Refresh(ctx context.Context, refreshToken string) (string, string, int, error)
+	RevokeRefreshToken(ctx context.Context, refreshToken string) error
+}
+
+// tokenClaims are the claims we mint and check on access tokens.
+// Tokens issued before revocation support carry neither jti nor ver, and
+// tokens issued before roles count as roleUser.
+type tokenClaims struct {
+	UserID    int
+	JTI       string
+	Version   int
+	Role      string
+	ExpiresAt time.Time
+}
+
+// User roles, from least to most privileged. Each role can do everything the
+// ones before it can: moderators work the report queue, admins also manage
+// accounts.
+const (
+	roleUser      = "user"
+	roleModerator = "moderator"
+	roleAdmin     = "admin"
+)
+
+var roleRank = map[string]int{roleUser: 0, roleModerator: 1, roleAdmin: 2}
+
+func validRole(role string) bool {
+	_, ok := roleRank[role]
+	return ok
+}
+
+// roleAtLeast reports whether role grants everything min does
+func roleAtLeast(role, min string) bool {
+	return validRole(role) && validRole(min) && roleRank[role] >= roleRank[min]
 }
 
 type authService struct {
-	repo AuthRepository
+	repo    AuthRepository
+	limiter *RateLimiter
 }
 
 func NewAuthService(repo AuthRepository) AuthService {
-	return &authService{repo: repo}
+	return &authService{repo: repo, limiter: rateLimiter}
 }
 
 func (s *authService) Register(ctx context.Context, email, password string) (string, int, error) {
@@ -49,11 +107,7 @@ func (s *authService) Register(ctx context.Context, email, password string) (str
 
 	_ = s.repo.UpdateLastOnline(ctx, newID)
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"user_id": newID,
-		"exp":     time.Now().Add(24 * time.Hour).Unix(),
-	})
-	tokenString, err := token.SignedString(jwtSecret)
+	tokenString, err := s.issueToken(ctx, newID)
 	if err != nil {
 		return "", 0, err
 	}
@@ -69,25 +123,28 @@ func (s *authService) Login(ctx context.Context, email, password string) (string
 		return "", 0, errors.New("missing_fields")
 	}
 
+	// A locked account is refused before the password is checked, so
+	// guesses made during the lockout tell nothing
+	if err := s.limiter.CheckLockout(ctx, email); err != nil {
+		return "", 0, err
+	}
+
 	userID, passwordHash, err := s.repo.GetUserByEmail(ctx, email)
 	if err != nil {
 		if errors.Is(err, ErrNotFound) {
-			return "", 0, ErrInvalidCredentials
+			return "", 0, s.loginFailed(ctx, email)
 		}
 		return "", 0, err
 	}
 
 	if err := bcrypt.CompareHashAndPassword([]byte(passwordHash), []byte(password)); err != nil {
-		return "", 0, ErrInvalidCredentials
+		return "", 0, s.loginFailed(ctx, email)
 	}
+	s.limiter.LoginSucceeded(ctx, email)
 
 	_ = s.repo.UpdateLastOnline(ctx, userID)
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"user_id": userID,
-		"exp":     time.Now().Add(24 * time.Hour).Unix(),
-	})
-	tokenString, err := token.SignedString(jwtSecret)
+	tokenString, err := s.issueToken(ctx, userID)
 	if err != nil {
 		return "", 0, err
 	}
@@ -95,24 +152,217 @@ func (s *authService) Login(ctx context.Context, email, password string) (string
 	return tokenString, userID, nil
 }
 
-func (s *authService) ValidateToken(tokenStr string) (int, error) {
+// loginFailed counts the failure against the account. The failure that
+// locks the account reports the lockout instead of invalid_credentials.
+func (s *authService) loginFailed(ctx context.Context, email string) error {
+	if err := s.limiter.LoginFailed(ctx, email); err != nil {
+		return err
+	}
+	return ErrInvalidCredentials
+}
+
+// issueToken mints an access token with a unique jti and the user's current
+// token version, so it can be revoked alone (Logout) or with all others (LogoutAll).
+// The role is carried as a claim; changing it bumps the token version.
+// Banned and suspended users get no token.
+func (s *authService) issueToken(ctx context.Context, userID int) (string, error) {
+	if err := s.checkAccountStatus(ctx, userID); err != nil {
+		return "", err
+	}
+	version, err := s.repo.GetTokenVersion(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	role, err := s.repo.GetRole(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	jti, err := newTokenID()
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"user_id": userID,
+		"jti":     jti,
+		"ver":     version,
+		"role":    role,
+		"iat":     now.Unix(),
+		"exp":     now.Add(tokenTTL).Unix(),
+	})
+	return token.SignedString(jwtSecret)
+}
+
+func newTokenID() (string, error) {
+	return randomHex(16)
+}
+
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// parseToken verifies the signature and expiry and extracts our claims. Only
+// HS256, the algorithm we sign with, is accepted.
+func parseToken(tokenStr string) (tokenClaims, error) {
 	token, err := jwt.Parse(tokenStr, func(token *jwt.Token) (interface{}, error) {
 		return jwtSecret, nil
-	})
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
 	if err != nil || !token.Valid {
-		return 0, errors.New("invalid_token")
+		return tokenClaims{}, errors.New("invalid_token")
 	}
 	claims, ok := token.Claims.(jwt.MapClaims)
 	if !ok {
-		return 0, errors.New("invalid_token_claims")
+		return tokenClaims{}, errors.New("invalid_token_claims")
 	}
 	userIDFloat, ok := claims["user_id"].(float64)
 	if !ok {
-		return 0, errors.New("invalid_user_id_in_token")
+		return tokenClaims{}, errors.New("invalid_user_id_in_token")
 	}
-	return int(userIDFloat), nil
+
+	tc := tokenClaims{UserID: int(userIDFloat)}
+	tc.JTI, _ = claims["jti"].(string)
+	if tc.Role, _ = claims["role"].(string); !validRole(tc.Role) {
+		tc.Role = roleUser
+	}
+	if ver, ok := claims["ver"].(float64); ok {
+		tc.Version = int(ver)
+	}
+	if exp, err := claims.GetExpirationTime(); err == nil && exp != nil {
+		tc.ExpiresAt = exp.Time
+	} else {
+		tc.ExpiresAt = time.Now().Add(tokenTTL)
+	}
+	return tc, nil
+}
+
+// ValidateToken checks the token signature and expiry, the account status and
+// the revocation store
+func (s *authService) ValidateToken(ctx context.Context, tokenStr string) (int, error) {
+	userID, _, err := s.ValidateTokenRole(ctx, tokenStr)
+	return userID, err
+}
+
+// ValidateTokenRole is ValidateToken, also returning the role claim
+func (s *authService) ValidateTokenRole(ctx context.Context, tokenStr string) (int, string, error) {
+	tc, err := parseToken(tokenStr)
+	if err != nil {
+		return 0, "", err
+	}
+	// Banning revokes the user's tokens too; the status check comes first so
+	// they learn why
+	if err := s.checkAccountStatus(ctx, tc.UserID); err != nil {
+		return 0, "", err
+	}
+	revoked, err := s.repo.IsTokenRevoked(ctx, tc.JTI, tc.UserID, tc.Version)
+	if err != nil {
+		return 0, "", err
+	}
+	if revoked {
+		return 0, "", ErrTokenRevoked
+	}
+	return tc.UserID, tc.Role, nil
+}
+
+// checkAccountStatus rejects banned users and users under a running suspension
+func (s *authService) checkAccountStatus(ctx context.Context, userID int) error {
+	status, err := s.repo.GetAccountStatus(ctx, userID)
+	if err != nil {
+		return err
+	}
+	switch status {
+	case accountBanned:
+		return ErrAccountBanned
+	case accountSuspended:
+		return ErrAccountSuspended
+	}
+	return nil
+}
+
+// Logout revokes the given token. Legacy tokens without a jti cannot be
+// revoked individually, so all of the user's sessions are revoked instead.
+func (s *authService) Logout(ctx context.Context, tokenStr string) error {
+	tc, err := parseToken(tokenStr)
+	if err != nil {
+		return err
+	}
+	if tc.JTI == "" {
+		return s.repo.RevokeAllTokens(ctx, tc.UserID)
+	}
+	return s.repo.RevokeToken(ctx, tc.JTI, tc.UserID, tc.ExpiresAt)
+}
+
+// LogoutAll invalidates every token issued to the user so far
+func (s *authService) LogoutAll(ctx context.Context, userID int) error {
+	return s.repo.RevokeAllTokens(ctx, userID)
 }
 
 func (s *authService) UpdateLastOnline(ctx context.Context, userID int) error {
 	return s.repo.UpdateLastOnline(ctx, userID)
 }
+
+// Refresh tokens are opaque random strings; only their SHA-256 is stored.
+// Each login starts a family, and every refresh rotates the token within it.
+func hashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// IssueRefreshToken starts a new refresh token family for the user
+func (s *authService) IssueRefreshToken(ctx context.Context, userID int) (string, error) {
+	token, err := randomHex(32)
+	if err != nil {
+		return "", err
+	}
+	familyID, err := randomHex(16)
+	if err != nil {
+		return "", err
+	}
+	expiresAt := time.Now().Add(refreshTokenTTL)
+	if err := s.repo.CreateRefreshToken(ctx, userID, hashRefreshToken(token), familyID, expiresAt); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// Refresh exchanges a refresh token for a new access token and a new refresh
+// token. Presenting an already rotated token means it leaked: the whole
+// family is revoked and the caller has to log in again.
+func (s *authService) Refresh(ctx context.Context, refreshToken string) (string, string, int, error) {
+	if strings.TrimSpace(refreshToken) == "" {
+		return "", "", 0, errors.New("missing_fields")
+	}
+
+	next, err := randomHex(32)
+	if err != nil {
+		return "", "", 0, err
+	}
+	userID, familyID, err := s.repo.RotateRefreshToken(ctx, hashRefreshToken(refreshToken), hashRefreshToken(next), time.Now().Add(refreshTokenTTL))
+	if err != nil {
+		if errors.Is(err, ErrRefreshReused) {
+			if rerr := s.repo.RevokeRefreshFamily(ctx, familyID); rerr != nil {
+				return "", "", 0, rerr
+			}
+			return "", "", 0, ErrRefreshReused
+		}
+		if errors.Is(err, ErrNotFound) {
+			return "", "", 0, ErrInvalidRefresh
+		}
+		return "", "", 0, err
+	}
+
+	access, err := s.issueToken(ctx, userID)
+	if err != nil {
+		return "", "", 0, err
+	}
+	return access, next, userID, nil
+}
+
+// RevokeRefreshToken ends the refresh token family the token belongs to
+func (s *authService) RevokeRefreshToken(ctx context.Context, refreshToken string) error {
+	return s.repo.RevokeRefreshToken(ctx, hashRefreshToken(refreshToken))
+}
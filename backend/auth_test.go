@@ -7,7 +7,9 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/golang-jwt/jwt/v5"
 	"golang.org/x/crypto/bcrypt"
 )
 
@@ -28,6 +30,14 @@ func TestAuthenticationSuite(t *testing.T) {
 	t.Run("Login", func(t *testing.T) {
 		testLogin(t)
 	})
+
+	t.Run("Logout", func(t *testing.T) {
+		testLogout(t)
+	})
+
+	t.Run("Refresh", func(t *testing.T) {
+		testRefresh(t)
+	})
 }
 
 func testRegistration(t *testing.T) {
@@ -187,3 +197,171 @@ func testLogin(t *testing.T) {
 		}
 	})
 }
+
+func testLogout(t *testing.T) {
+	email := "logout_test@example.com"
+	password := "testpass123"
+	defer cleanupTestData(email)
+
+	user := createTestUser(t, email, password)
+	other := loginUser(t, email, password)
+
+	// meAs calls an authenticated endpoint and returns the status code
+	meAs := func(token string) int {
+		req := httptest.NewRequest(http.MethodPost, "/me/ping", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		w := httptest.NewRecorder()
+		mePingHandler(db).ServeHTTP(w, req)
+		return w.Code
+	}
+	post := func(h http.HandlerFunc, path, token string) int {
+		req := httptest.NewRequest(http.MethodPost, path, nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	t.Run("Revokes only the presented token", func(t *testing.T) {
+		if code := post(logoutHandler(db), "/logout", user.Token); code != http.StatusNoContent {
+			t.Fatalf("expected status %d, got %d", http.StatusNoContent, code)
+		}
+		if code := meAs(user.Token); code != http.StatusUnauthorized {
+			t.Errorf("expected revoked token to be rejected, got %d", code)
+		}
+		if _, ok := parseUserIDFromJWT(user.Token); ok {
+			t.Error("expected WebSocket auth to reject the revoked token")
+		}
+		if code := meAs(other); code != http.StatusNoContent {
+			t.Errorf("expected other session to stay valid, got %d", code)
+		}
+	})
+
+	t.Run("Log out all sessions", func(t *testing.T) {
+		third := loginUser(t, email, password)
+		if code := post(logoutAllHandler(db), "/logout/all", third); code != http.StatusNoContent {
+			t.Fatalf("expected status %d, got %d", http.StatusNoContent, code)
+		}
+		for _, token := range []string{other, third} {
+			if code := meAs(token); code != http.StatusUnauthorized {
+				t.Errorf("expected token to be rejected after logout-all, got %d", code)
+			}
+		}
+
+		// Logging in again still works
+		fresh := loginUser(t, email, password)
+		if code := meAs(fresh); code != http.StatusNoContent {
+			t.Errorf("expected new token to be accepted, got %d", code)
+		}
+	})
+}
+
+func testRefresh(t *testing.T) {
+	email := "refresh_test@example.com"
+	password := "testpass123"
+	defer cleanupTestData(email)
+
+	createTestUser(t, email, password)
+
+	// login returns the refresh token issued alongside the access token
+	login := func() string {
+		reqBody := []byte(fmt.Sprintf(`{"email":"%s","password":"%s"}`, email, password))
+		req := httptest.NewRequest(http.MethodPost, "/login", bytes.NewBuffer(reqBody))
+		w := httptest.NewRecorder()
+		loginHandler(db).ServeHTTP(w, req)
+
+		var resp struct {
+			RefreshToken string `json:"refresh_token"`
+		}
+		json.NewDecoder(w.Body).Decode(&resp)
+		if resp.RefreshToken == "" {
+			t.Fatal("expected refresh_token in login response")
+		}
+		return resp.RefreshToken
+	}
+	refresh := func(token string) (int, string) {
+		reqBody := []byte(fmt.Sprintf(`{"refresh_token":"%s"}`, token))
+		req := httptest.NewRequest(http.MethodPost, "/token/refresh", bytes.NewBuffer(reqBody))
+		w := httptest.NewRecorder()
+		tokenRefreshHandler(db).ServeHTTP(w, req)
+
+		var resp struct {
+			Token        string `json:"token"`
+			RefreshToken string `json:"refresh_token"`
+		}
+		json.NewDecoder(w.Body).Decode(&resp)
+		return w.Code, resp.RefreshToken
+	}
+
+	t.Run("Rotation", func(t *testing.T) {
+		first := login()
+		code, second := refresh(first)
+		if code != http.StatusOK || second == "" || second == first {
+			t.Fatalf("expected a rotated refresh token, got status %d", code)
+		}
+		if code, _ := refresh(second); code != http.StatusOK {
+			t.Errorf("expected the rotated token to work, got %d", code)
+		}
+	})
+
+	t.Run("Reuse revokes the family", func(t *testing.T) {
+		first := login()
+		_, second := refresh(first)
+
+		if code, _ := refresh(first); code != http.StatusUnauthorized {
+			t.Errorf("expected reuse to be rejected, got %d", code)
+		}
+		if code, _ := refresh(second); code != http.StatusUnauthorized {
+			t.Errorf("expected the whole family to be revoked after reuse, got %d", code)
+		}
+	})
+
+	t.Run("Unknown token", func(t *testing.T) {
+		if code, _ := refresh("not-a-refresh-token"); code != http.StatusUnauthorized {
+			t.Errorf("expected status %d, got %d", http.StatusUnauthorized, code)
+		}
+	})
+
+	t.Run("Missing token", func(t *testing.T) {
+		if code, _ := refresh(""); code != http.StatusBadRequest {
+			t.Errorf("expected status %d, got %d", http.StatusBadRequest, code)
+		}
+	})
+}
+
+func TestParseTokenRole(t *testing.T) {
+	sign := func(claims jwt.MapClaims) string {
+		s, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(jwtSecret)
+		return s
+	}
+	exp := time.Now().Add(time.Minute).Unix()
+
+	tc, err := parseToken(sign(jwt.MapClaims{"user_id": 1, "role": "moderator", "exp": exp}))
+	if err != nil || tc.Role != roleModerator {
+		t.Fatalf("expected the moderator claim, got %q %v", tc.Role, err)
+	}
+	for _, claims := range []jwt.MapClaims{{"user_id": 1, "exp": exp}, {"user_id": 1, "role": "root", "exp": exp}} {
+		if tc, err := parseToken(sign(claims)); err != nil || tc.Role != roleUser {
+			t.Fatalf("expected a missing or unknown role to count as user, got %q %v", tc.Role, err)
+		}
+	}
+
+	if !roleAtLeast(roleAdmin, roleModerator) || roleAtLeast(roleModerator, roleAdmin) || roleAtLeast("root", roleUser) {
+		t.Fatal("unexpected role ordering")
+	}
+}
+
+func TestParseTokenAlgorithm(t *testing.T) {
+	claims := jwt.MapClaims{"user_id": 1, "exp": time.Now().Add(time.Minute).Unix()}
+
+	hs384, _ := jwt.NewWithClaims(jwt.SigningMethodHS384, claims).SignedString(jwtSecret)
+	none, _ := jwt.NewWithClaims(jwt.SigningMethodNone, claims).SignedString(jwt.UnsafeAllowNoneSignatureType)
+	for name, token := range map[string]string{"HS384": hs384, "none": none} {
+		if _, err := parseToken(token); err == nil {
+			t.Errorf("expected a %s token to be rejected", name)
+		}
+		if _, err := jwtParse(token); err == nil {
+			t.Errorf("expected a %s token to be rejected on the WebSocket", name)
+		}
+	}
+}
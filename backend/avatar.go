@@ -150,19 +150,11 @@ func getUserAvatarHandler(db *sql.DB) http.HandlerFunc {
 
 		me := r.Context().Value(userIDKey).(int)
 
-		// Own picture ok. Otherwise a pending/accepted/recommended relationship must exist.
-		if me != targetID {
-			ok := canViewUser(r.Context(), db, me, targetID)
-
-			// Check if the requested user is recommendable and if so, allow viewing
-			if !ok {
-				ok, _ = isCurrentlyRecommendable(r.Context(), db, me, targetID)
-			}
-			if !ok {
-				// 404 so that the file existence is not revealed to bad actors
-				http.NotFound(w, r)
-				return
-			}
+		// Same visibility rules as the profile itself
+		if !canViewUser(r.Context(), db, me, targetID) {
+			// 404 so that the file existence is not revealed to bad actors
+			http.NotFound(w, r)
+			return
 		}
 
 		// Read the filename from the database
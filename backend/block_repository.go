@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+)
+
+// BlockRepository defines the data access methods for blocks
+type BlockRepository interface {
+	InsertBlock(ctx context.Context, tx *sql.Tx, blockerID, blockedID int) (bool, error)
+	DeleteBlock(ctx context.Context, blockerID, blockedID int) (bool, error)
+	GetBlocked(ctx context.Context, userID int) ([]int, error)
+	IsBlocked(ctx context.Context, a, b int) (bool, error)
+	UserExists(ctx context.Context, userID int) (bool, error)
+}
+
+type sqlBlockRepo struct {
+	db *sql.DB
+}
+
+func NewBlockRepository(db *sql.DB) BlockRepository {
+	return &sqlBlockRepo{db: db}
+}
+
+// InsertBlock records the block, reporting false when it already existed
+func (r *sqlBlockRepo) InsertBlock(ctx context.Context, tx *sql.Tx, blockerID, blockedID int) (bool, error) {
+	res, err := tx.ExecContext(ctx, `
+		INSERT INTO blocks (blocker_id, blocked_id)
+		VALUES ($1, $2)
+		ON CONFLICT DO NOTHING
+	`, blockerID, blockedID)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
+
+// DeleteBlock lifts the block, reporting false when there was none
+func (r *sqlBlockRepo) DeleteBlock(ctx context.Context, blockerID, blockedID int) (bool, error) {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM blocks WHERE blocker_id = $1 AND blocked_id = $2`, blockerID, blockedID)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
+
+// GetBlocked lists the users userID blocked, most recent first
+func (r *sqlBlockRepo) GetBlocked(ctx context.Context, userID int) ([]int, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT blocked_id FROM blocks
+		WHERE blocker_id = $1
+		ORDER BY created_at DESC, blocked_id
+	`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := []int{}
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+func (r *sqlBlockRepo) UserExists(ctx context.Context, userID int) (bool, error) {
+	var exists bool
+	err := r.db.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM users WHERE id = $1)`, userID).Scan(&exists)
+	return exists, err
+}
+
+func (r *sqlBlockRepo) IsBlocked(ctx context.Context, a, b int) (bool, error) {
+	return isBlocked(ctx, r.db, a, b)
+}
+
+// blockedPairSQL matches a block between $1 and $2, in either direction
+const blockedPairSQL = `
+		SELECT 1 FROM blocks
+		WHERE (blocker_id = $1 AND blocked_id = $2)
+		   OR (blocker_id = $2 AND blocked_id = $1)`
+
+// queryRower is satisfied by *sql.DB and *sql.Tx
+type queryRower interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// isBlocked reports whether either user blocked the other
+func isBlocked(ctx context.Context, q queryRower, a, b int) (bool, error) {
+	var blocked bool
+	err := q.QueryRowContext(ctx, `SELECT EXISTS (`+blockedPairSQL+`)`, a, b).Scan(&blocked)
+	return blocked, err
+}
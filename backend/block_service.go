@@ -0,0 +1,112 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"gitea.kood.tech/petrkubec/match-me/backend/events"
+)
+
+// ErrBlocked is returned when one of two users blocked the other
+var ErrBlocked = errors.New("blocked")
+
+// BlockService manages blocks. A block works in both directions: neither
+// user can message, connect with, view or be recommended to the other, no
+// matter which of them created it.
+type BlockService interface {
+	BlockUser(ctx context.Context, me, targetID int) error
+	UnblockUser(ctx context.Context, me, targetID int) error
+	GetBlocked(ctx context.Context, userID int) ([]int, error)
+	IsBlocked(ctx context.Context, a, b int) (bool, error)
+}
+
+type blockService struct {
+	db    *sql.DB
+	repo  BlockRepository
+	conns ConnectionRepository
+}
+
+func NewBlockService(db *sql.DB, repo BlockRepository) BlockService {
+	return &blockService{
+		db:    db,
+		repo:  repo,
+		conns: NewConnectionRepository(),
+	}
+}
+
+// BlockUser blocks targetID and ends any connection between the two: a
+// pending request is dismissed and an accepted connection disconnected.
+// Blocking twice is a no-op.
+func (s *blockService) BlockUser(ctx context.Context, me, targetID int) error {
+	if me == targetID {
+		return ErrInvalidTarget
+	}
+	exists, err := s.repo.UserExists(ctx, targetID)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrNotFound
+	}
+
+	var created bool
+	var state string
+	err = withTx(ctx, s.db, func(tx *sql.Tx) error {
+		var err error
+		if created, err = s.repo.InsertBlock(ctx, tx, me, targetID); err != nil {
+			return err
+		}
+
+		row, err := s.conns.LoadPairForUpdate(tx, me, targetID)
+		if err != nil || row == nil {
+			return err
+		}
+		switch row.Status {
+		case "pending":
+			state = "dismissed"
+		case "accepted":
+			state = "disconnected"
+		default:
+			return nil
+		}
+		_, err = s.conns.UpdateStatus(ctx, tx, row.ID, state)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if state != "" {
+		publishConnection(me, targetID, state)
+	}
+	if created {
+		events.Default().PublishBlock(events.Block{UserID: me, BlockedID: targetID, Blocked: true})
+	}
+	return nil
+}
+
+// UnblockUser lifts a block created by me. Connections ended by the block
+// stay ended. Unblocking someone who is not blocked is a no-op.
+func (s *blockService) UnblockUser(ctx context.Context, me, targetID int) error {
+	if me == targetID {
+		return ErrInvalidTarget
+	}
+	removed, err := s.repo.DeleteBlock(ctx, me, targetID)
+	if err != nil {
+		return err
+	}
+	if removed {
+		events.Default().PublishBlock(events.Block{UserID: me, BlockedID: targetID, Blocked: false})
+	}
+	return nil
+}
+
+func (s *blockService) GetBlocked(ctx context.Context, userID int) ([]int, error) {
+	return s.repo.GetBlocked(ctx, userID)
+}
+
+// IsBlocked reports whether either user blocked the other
+func (s *blockService) IsBlocked(ctx context.Context, a, b int) (bool, error) {
+	return s.repo.IsBlocked(ctx, a, b)
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"database/sql"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// GET /blocks - Lists the ids of the users I blocked
+func blocksHandler(db *sql.DB) http.HandlerFunc {
+	svc := NewBlockService(db, NewBlockRepository(db))
+	return authenticate(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			writeError(w, http.StatusMethodNotAllowed, "invalid_method")
+			return
+		}
+		userID := r.Context().Value(userIDKey).(int)
+
+		blocked, err := svc.GetBlocked(r.Context(), userID)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "db_error")
+			log.Println("blocksHandler error:", err)
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string][]int{"blocked": blocked})
+	})
+}
+
+// POST /blocks/{id} - Blocks a user, ending any connection with them
+// DELETE /blocks/{id} - Lifts the block
+func blockActionsHandler(db *sql.DB) http.HandlerFunc {
+	svc := NewBlockService(db, NewBlockRepository(db))
+	return authenticate(func(w http.ResponseWriter, r *http.Request) {
+		parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+		if len(parts) != 2 || parts[0] != "blocks" {
+			http.NotFound(w, r)
+			return
+		}
+		targetID, err := strconv.Atoi(parts[1])
+		if err != nil {
+			writeError(w, http.StatusNotFound, "not_found")
+			return
+		}
+
+		me := r.Context().Value(userIDKey).(int)
+
+		switch r.Method {
+		case http.MethodPost:
+			err = svc.BlockUser(r.Context(), me, targetID)
+		case http.MethodDelete:
+			err = svc.UnblockUser(r.Context(), me, targetID)
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "invalid_method")
+			return
+		}
+		if err != nil {
+			if errors.Is(err, ErrInvalidTarget) {
+				writeError(w, http.StatusBadRequest, "invalid_target")
+				return
+			}
+			if errors.Is(err, ErrNotFound) {
+				writeError(w, http.StatusNotFound, "not_found")
+				return
+			}
+			writeError(w, http.StatusInternalServerError, "db_error")
+			log.Println("blockActionsHandler error:", err)
+			return
+		}
+
+		if r.Method == http.MethodPost {
+			writeJSON(w, http.StatusOK, map[string]bool{"blocked": true})
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestBlockSystem(t *testing.T) {
+	alice := createTestUserForConnections(t, "block_alice@test.com", "password123")
+	bob := createTestUserForConnections(t, "block_bob@test.com", "password123")
+	defer cleanupConnectionTestData("block_alice@test.com", "block_bob@test.com")
+
+	if _, err := db.Exec(`INSERT INTO connections (user_id, target_user_id, status) VALUES ($1, $2, 'accepted')`, alice.ID, bob.ID); err != nil {
+		t.Fatalf("failed to connect users: %v", err)
+	}
+	ctx := context.Background()
+
+	if w := authedCall(alice.Token, http.MethodPost, fmt.Sprintf("/blocks/%d", alice.ID), nil, blockActionsHandler(db)); w.Code != http.StatusBadRequest {
+		t.Fatalf("expected blocking yourself to fail, got %d", w.Code)
+	}
+	if w := authedCall(alice.Token, http.MethodPost, fmt.Sprintf("/blocks/%d", bob.ID), nil, blockActionsHandler(db)); w.Code != http.StatusOK {
+		t.Fatalf("expected block to succeed, got %d: %s", w.Code, w.Body.String())
+	}
+
+	var status string
+	db.QueryRow(`SELECT status FROM connections WHERE user_id = $1 AND target_user_id = $2`, alice.ID, bob.ID).Scan(&status)
+	if status != "disconnected" {
+		t.Fatalf("expected the block to disconnect the pair, got %q", status)
+	}
+
+	// enforced in both directions
+	repo := NewChatRepository(db)
+	if _, _, err := repo.SaveChatMsg(ctx, bob.ID, alice.ID, "hi", 0, ""); !errors.Is(err, ErrBlocked) {
+		t.Fatalf("expected the blocked user's message to be rejected, got %v", err)
+	}
+	if canViewUser(ctx, db, alice.ID, bob.ID) || canViewUser(ctx, db, bob.ID, alice.ID) {
+		t.Fatal("expected a blocked pair not to see each other")
+	}
+	if w := authedCall(bob.Token, http.MethodGet, fmt.Sprintf("/avatars/%d", alice.ID), nil, getUserAvatarHandler(db)); w.Code != http.StatusNotFound {
+		t.Fatalf("expected the avatar to be hidden, got %d", w.Code)
+	}
+	if w := authedCall(bob.Token, http.MethodPost, fmt.Sprintf("/connections/%d/request", alice.ID), nil, requestConnectionHandler(db)); w.Code != http.StatusNotFound {
+		t.Fatalf("expected a connection request to look like a missing user, got %d", w.Code)
+	}
+
+	w := authedCall(alice.Token, http.MethodGet, "/blocks", nil, blocksHandler(db))
+	var list map[string][]int
+	json.Unmarshal(w.Body.Bytes(), &list)
+	if len(list["blocked"]) != 1 || list["blocked"][0] != bob.ID {
+		t.Fatalf("expected bob in the block list, got %s", w.Body.String())
+	}
+
+	if w := authedCall(alice.Token, http.MethodDelete, fmt.Sprintf("/blocks/%d", bob.ID), nil, blockActionsHandler(db)); w.Code != http.StatusNoContent {
+		t.Fatalf("expected unblock to succeed, got %d", w.Code)
+	}
+	if blocked, err := isBlocked(ctx, db, bob.ID, alice.ID); err != nil || blocked {
+		t.Fatalf("expected the block to be lifted, got %v %v", blocked, err)
+	}
+}
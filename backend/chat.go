@@ -4,7 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
-	"fmt"
+	"errors"
 	"log"
 	"net/http"
 	"strconv"
@@ -12,6 +12,9 @@ import (
 	"sync"
 	"time"
 
+	"gitea.kood.tech/petrkubec/match-me/backend/events"
+	"gitea.kood.tech/petrkubec/match-me/backend/graph"
+	"gitea.kood.tech/petrkubec/match-me/backend/graph/model"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/gorilla/websocket"
 )
@@ -19,27 +22,140 @@ import (
 // ChatMessage represents a chat message with metadata
 type ChatMessage struct {
 	ID     int64     `json:"id"`
-	Type   string    `json:"type"` // "message"
+	Type   string    `json:"type"` // "message" | "edit" | "delete"
 	ChatID int       `json:"chat_id"`
 	From   int       `json:"from"`
 	To     int       `json:"to,omitempty"`
 	Body   string    `json:"body,omitempty"`
 	Ts     time.Time `json:"ts"` // created_at
+
+	// EditedAt is set once the sender edited the message. DeletedAt marks a
+	// tombstone: the sender deleted it and Body is empty.
+	EditedAt  *time.Time `json:"edited_at,omitempty"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+
+	// DeliveredAt is when the recipient's client acknowledged the message,
+	// ReadAt when the recipient read it
+	DeliveredAt *time.Time `json:"delivered_at,omitempty"`
+	ReadAt      *time.Time `json:"read_at,omitempty"`
+
+	// ReplyToID is the message this one quotes, in the same chat. ReplyTo is
+	// the quote's preview, filled in by the server.
+	ReplyToID int64           `json:"reply_to_id,omitempty"`
+	ReplyTo   *MessagePreview `json:"reply_to,omitempty"`
+
+	// ClientMsgID is the sender client's own ID for a "message" frame:
+	// resending it never creates a duplicate, and the answering "ack" or
+	// "error" event carries it
+	ClientMsgID string `json:"client_msg_id,omitempty"`
+
+	// Reactions are the emoji counts, in history only
+	Reactions []Reaction `json:"reactions,omitempty"`
+
+	// Emoji is only used by "react" and "unreact" frames
+	Emoji string `json:"emoji,omitempty"`
+
+	// Typing is only used by "typing" frames: true (or omitted) starts the
+	// indicator, false stops it.
+	Typing *bool `json:"typing,omitempty"`
+
+	// Token is only used by "auth" frames, which renew the connection's
+	// access token after a "reauth" event.
+	Token string `json:"token,omitempty"`
+}
+
+// MessageEdit is one entry of a message's edit history: the content an edit
+// replaced, and when
+type MessageEdit struct {
+	Body     string    `json:"body"`
+	EditedAt time.Time `json:"edited_at"`
+}
+
+// MessagePreview is the compact form of a quoted message. Body is cut after
+// replyPreviewLen characters and empty once the quoted message was deleted.
+type MessagePreview struct {
+	ID      int64  `json:"id"`
+	From    int    `json:"from"`
+	Body    string `json:"body"`
+	Deleted bool   `json:"deleted,omitempty"`
+}
+
+// Reaction is how many users reacted to a message with an emoji. Mine is
+// true when the viewing user is one of them.
+type Reaction struct {
+	Emoji string `json:"emoji"`
+	Count int    `json:"count"`
+	Mine  bool   `json:"mine"`
+}
+
+// SendAck is the payload of the "ack" event answering a "message" frame on
+// the connection that sent it. A retried frame is acknowledged with the
+// message stored the first time.
+type SendAck struct {
+	ClientMsgID string    `json:"client_msg_id,omitempty"`
+	ID          int64     `json:"id"`
+	ChatID      int       `json:"chat_id"`
+	Ts          time.Time `json:"ts"`
+}
+
+// SendFailure is the payload of the "error" event answering a "message" frame
+// that was not sent. RetryAfter is set for rate_limited.
+type SendFailure struct {
+	ClientMsgID string `json:"client_msg_id,omitempty"`
+	Code        string `json:"code"`
+	Error       string `json:"error"`
+	RetryAfter  int    `json:"retry_after,omitempty"`
+}
+
+// Receipt is the payload of "delivered" and "read" events, sent to the
+// sender of the messages: the recipient in the event's From received or read
+// them at At
+type Receipt struct {
+	ChatID     int       `json:"chat_id"`
+	MessageIDs []int64   `json:"message_ids"`
+	At         time.Time `json:"at"`
+
+	SenderID    int `json:"-"`
+	RecipientID int `json:"-"`
+}
+
+// Receipt statuses, also the types of the events that carry them
+const (
+	receiptDelivered = "delivered"
+	receiptRead      = "read"
+)
+
+// ReactionEvent is the payload of a "reaction" event: the user in From added
+// or removed the emoji, which Count users now reacted with
+type ReactionEvent struct {
+	MessageID int64  `json:"message_id"`
+	ChatID    int    `json:"chat_id"`
+	Emoji     string `json:"emoji"`
+	Added     bool   `json:"added"`
+	Count     int    `json:"count"`
 }
 
 // ServerEvent represents a server-sent event
 type ServerEvent struct {
-	Type string `json:"type"` // "message" | "typing" | "info" | "error"
+	Type string `json:"type"` // "message" | "ack" | "edit" | "delete" | "reaction" | "delivered" | "read" | "typing" | "presence" | "reauth" | "info" | "error"
 	From int    `json:"from,omitempty"`
 	Data any    `json:"data,omitempty"`
 }
 
+// wsReauthGrace is how long a client may keep the connection open after its
+// access token expired, giving it time to refresh and send an "auth" frame.
+const wsReauthGrace = 30 * time.Second
+
 // Client represents a WebSocket client connection
 type Client struct {
-	userID  int
-	conn    *websocket.Conn
-	send    chan ServerEvent
-	chatSvc ChatService
+	userID   int
+	conn     *websocket.Conn
+	send     chan ServerEvent
+	chatSvc  ChatService
+	blockSvc BlockService
+
+	tokenExp time.Time      // expiry of the token the connection is authenticated with
+	reauth   chan time.Time // new expiries from "auth" frames, consumed by clientWriter
 }
 
 // Hub manages WebSocket client connections
@@ -56,22 +172,30 @@ func newHub() *Hub {
 
 func (h *Hub) register(c *Client) {
 	h.mu.Lock()
-	defer h.mu.Unlock()
 	if h.clientsByUser[c.userID] == nil {
 		h.clientsByUser[c.userID] = make(map[*Client]bool)
 	}
 	h.clientsByUser[c.userID][c] = true
+	h.mu.Unlock()
+
+	userPresence.Connect(c.userID)
 }
 
 func (h *Hub) unregister(c *Client) {
 	h.mu.Lock()
-	defer h.mu.Unlock()
-	if peers, ok := h.clientsByUser[c.userID]; ok {
+	removed := false
+	if peers, ok := h.clientsByUser[c.userID]; ok && peers[c] {
 		delete(peers, c)
+		removed = true
 		if len(peers) == 0 {
 			delete(h.clientsByUser, c.userID)
 		}
 	}
+	h.mu.Unlock()
+
+	if removed {
+		userPresence.Disconnect(c.userID)
+	}
 }
 
 func (h *Hub) sendToUser(userID int, evt ServerEvent) {
@@ -88,6 +212,125 @@ func (h *Hub) sendToUser(userID int, evt ServerEvent) {
 	}
 }
 
+// handleEvent forwards bus events to the connected WebSocket clients.
+// Messages are delivered to the recipient and echoed back to the sender, no
+// matter which transport (WS or GraphQL) they were sent through.
+func (h *Hub) handleEvent(evt events.Event) {
+	switch evt.Type {
+	case events.MessageCreated:
+		msg, ok := evt.Payload.(events.Message)
+		if !ok {
+			return
+		}
+		m := ChatMessage{
+			ID:     msg.ID,
+			Type:   "message",
+			ChatID: msg.ChatID,
+			From:   msg.SenderID,
+			To:     msg.RecipientID,
+			Body:   msg.Body,
+			Ts:     msg.CreatedAt,
+
+			ClientMsgID: msg.ClientMsgID,
+		}
+		if q := msg.ReplyTo; q != nil {
+			m.ReplyToID = q.ID
+			m.ReplyTo = &MessagePreview{ID: q.ID, From: q.SenderID, Body: q.Body, Deleted: q.Deleted}
+		}
+		out := ServerEvent{Type: "message", From: msg.SenderID, Data: m}
+		h.sendToUser(msg.RecipientID, out)
+		h.sendToUser(msg.SenderID, out) // echo so sender UI updates instantly
+
+	case events.MessageEdited, events.MessageDeleted:
+		c, ok := evt.Payload.(events.MessageChange)
+		if !ok {
+			return
+		}
+		data := ChatMessage{
+			ID:     c.ID,
+			ChatID: c.ChatID,
+			From:   c.SenderID,
+			To:     c.RecipientID,
+			Body:   c.Body,
+			Ts:     c.CreatedAt,
+		}
+		if evt.Type == events.MessageEdited {
+			data.Type, data.EditedAt = "edit", &c.At
+		} else {
+			data.Type, data.DeletedAt = "delete", &c.At
+		}
+		out := ServerEvent{Type: data.Type, From: c.SenderID, Data: data}
+		h.sendToUser(c.RecipientID, out)
+		h.sendToUser(c.SenderID, out)
+
+	case events.ReactionChanged:
+		rc, ok := evt.Payload.(events.Reaction)
+		if !ok {
+			return
+		}
+		out := ServerEvent{
+			Type: "reaction",
+			From: rc.UserID,
+			Data: ReactionEvent{MessageID: rc.MessageID, ChatID: rc.ChatID, Emoji: rc.Emoji, Added: rc.Added, Count: rc.Count},
+		}
+		h.sendToUser(rc.PeerID, out)
+		h.sendToUser(rc.UserID, out)
+
+	case events.MessageStatus:
+		rc, ok := evt.Payload.(events.Receipt)
+		if !ok {
+			return
+		}
+		h.sendToUser(rc.SenderID, ServerEvent{
+			Type: rc.Status,
+			From: rc.RecipientID,
+			Data: Receipt{ChatID: rc.ChatID, MessageIDs: rc.MessageIDs, At: rc.At},
+		})
+
+	case events.PresenceChanged:
+		p, ok := evt.Payload.(events.Presence)
+		if !ok {
+			return
+		}
+		out := ServerEvent{
+			Type: "presence",
+			From: p.UserID,
+			Data: PresenceEvent{UserID: p.UserID, IsOnline: p.Online, LastOnline: p.LastOnline},
+		}
+		for _, uid := range p.Audience {
+			h.sendToUser(uid, out)
+		}
+
+	case events.TypingChanged:
+		t, ok := evt.Payload.(events.Typing)
+		if !ok {
+			return
+		}
+		h.sendToUser(t.PeerID, ServerEvent{
+			Type: "typing",
+			From: t.UserID,
+			Data: TypingEvent{ChatID: t.ChatID, Typing: t.Typing},
+		})
+
+	case events.AccountChanged:
+		a, ok := evt.Payload.(events.Account)
+		if !ok || a.Status == "active" {
+			return
+		}
+		h.disconnectUser(a.UserID)
+	}
+}
+
+// disconnectUser closes every connection of the user. Their readers fail and
+// unregister them as usual.
+func (h *Hub) disconnectUser(userID int) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for c := range h.clientsByUser[userID] {
+		_ = c.conn.Close()
+	}
+}
+
 var upgrader = websocket.Upgrader{
 	ReadBufferSize:  1024,
 	WriteBufferSize: 1024,
@@ -101,11 +344,12 @@ var chatHub = newHub()
 func wsChatHandler(db *sql.DB) http.HandlerFunc {
 	repo := NewChatRepository(db)
 	svc := NewChatService(repo, db)
+	blockSvc := NewBlockService(db, NewBlockRepository(db))
 
 	// WebSocket upgrade hijacks the response, so we cannot use the authenticate() wrapper.
 	// Auth is handled inline via getUserIDFromRequest.
 	return func(w http.ResponseWriter, r *http.Request) {
-		userID, ok := getUserIDFromRequest(r)
+		userID, tokenExp, ok := authenticateRequestToken(r)
 		if !ok {
 			writeError(w, http.StatusUnauthorized, "unauthorized")
 			return
@@ -118,10 +362,13 @@ func wsChatHandler(db *sql.DB) http.HandlerFunc {
 		}
 
 		client := &Client{
-			userID:  userID,
-			conn:    conn,
-			send:    make(chan ServerEvent, 16),
-			chatSvc: svc,
+			userID:   userID,
+			conn:     conn,
+			send:     make(chan ServerEvent, 16),
+			chatSvc:  svc,
+			blockSvc: blockSvc,
+			tokenExp: tokenExp,
+			reauth:   make(chan time.Time, 1),
 		}
 		chatHub.register(client)
 
@@ -135,53 +382,47 @@ func wsChatHandler(db *sql.DB) http.HandlerFunc {
 	}
 }
 
-// Extract user ID from Authorization header using the existing jwtSecret
-func getUserIDFromBearer(r *http.Request) (int, bool) {
-	auth := r.Header.Get("Authorization")
-	if len(auth) < 8 || auth[:7] != "Bearer " {
-		return 0, false
-	}
-	tokenStr := auth[7:]
-	id, ok := parseUserIDFromJWT(tokenStr)
+// Extract user ID from Authorization header or the token query param
+func getUserIDFromRequest(r *http.Request) (int, bool) {
+	id, _, ok := authenticateRequestToken(r)
 	return id, ok
 }
 
-func getUserIDFromRequest(r *http.Request) (int, bool) {
-	// Try Authorization header first
-	if id, ok := getUserIDFromBearer(r); ok {
-		return id, true
+// authenticateRequestToken validates the Authorization header first and falls
+// back to the token query param (browsers can't set headers on WS). It
+// returns the user and the expiry of the accepted token.
+func authenticateRequestToken(r *http.Request) (int, time.Time, bool) {
+	var candidates []string
+	if auth := r.Header.Get("Authorization"); len(auth) >= 8 && auth[:7] == "Bearer " {
+		candidates = append(candidates, auth[7:])
 	}
-	// Fallback: token query param for WS (browsers can't set headers)
-	q := r.URL.Query().Get("token")
-	if q != "" {
-		return parseUserIDFromJWT(q)
+	if q := r.URL.Query().Get("token"); q != "" {
+		candidates = append(candidates, q)
 	}
-	return 0, false
-}
-
-func parseUserIDFromJWT(tokenStr string) (int, bool) {
-	claims := jwt.MapClaims{}
 
-	token, err := jwt.ParseWithClaims(tokenStr, claims, func(t *jwt.Token) (interface{}, error) {
-		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
+	for _, tokenStr := range candidates {
+		if id, ok := parseUserIDFromJWT(tokenStr); ok {
+			claims, _ := parseToken(tokenStr)
+			return id, claims.ExpiresAt, true
 		}
-		return jwtSecret, nil
-	})
-	if err != nil || !token.Valid {
-		return 0, false
 	}
+	return 0, time.Time{}, false
+}
 
-	fv, ok := claims["user_id"].(float64)
-	if !ok {
+func parseUserIDFromJWT(tokenStr string) (int, bool) {
+	// Same checks as authenticate(), including the revocation store
+	svc := NewAuthService(NewAuthRepository(db))
+	userID, err := svc.ValidateToken(context.Background(), tokenStr)
+	if err != nil {
 		return 0, false
 	}
-	return int(fv), true
+	return userID, true
 }
 
 // tiny helper to use the existing jwtSecret without reimport noise
 func jwtParse(s string) (*jwt.Token, error) {
-	return jwt.Parse(s, func(token *jwt.Token) (any, error) { return jwtSecret, nil })
+	return jwt.Parse(s, func(token *jwt.Token) (any, error) { return jwtSecret, nil },
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
 }
 
 // clientReader handles incoming WebSocket messages from a connected client.
@@ -212,26 +453,111 @@ func clientReader(c *Client) {
 
 		switch msg.Type {
 		case "message":
-			outMsg, err := c.chatSvc.SendMessage(context.Background(), c.userID, msg.To, msg.Body)
+			if err := c.limit(limitWSSend); err != nil {
+				c.send <- ServerEvent{Type: "error", Data: sendFailure(msg.ClientMsgID, err)}
+				continue
+			}
+			// Delivery to both participants happens through the event bus
+			// (see Hub.handleEvent), so GraphQL subscribers receive it as well.
+			sent, err := c.chatSvc.SendMessage(context.Background(), c.userID, msg.To, msg.Body, msg.ReplyToID, msg.ClientMsgID)
 			if err != nil {
-				c.send <- ServerEvent{Type: "error", Data: "cannot send message"}
+				c.send <- ServerEvent{Type: "error", Data: sendFailure(msg.ClientMsgID, err)}
 				continue
 			}
+			c.send <- ServerEvent{Type: "ack", Data: SendAck{
+				ClientMsgID: msg.ClientMsgID,
+				ID:          sent.ID,
+				ChatID:      sent.ChatID,
+				Ts:          sent.Ts,
+			}}
+
+		case "edit", "delete":
+			if !c.allow(limitWSSend) {
+				continue
+			}
+			var err error
+			if msg.Type == "edit" {
+				_, err = c.chatSvc.EditMessage(context.Background(), c.userID, msg.ID, msg.Body)
+			} else {
+				_, err = c.chatSvc.DeleteMessage(context.Background(), c.userID, msg.ID)
+			}
+			// Success is confirmed by the edit/delete event echoed to the sender
+			if err != nil {
+				c.send <- ServerEvent{Type: "error", Data: messageChangeError(msg.Type, err)}
+			}
 
-			out := ServerEvent{
-				Type: "message",
-				From: c.userID,
-				Data: outMsg,
+		case "react", "unreact":
+			if !c.allow(limitWSSend) {
+				continue
+			}
+			var err error
+			if msg.Type == "react" {
+				_, err = c.chatSvc.AddReaction(context.Background(), c.userID, msg.ID, msg.Emoji)
+			} else {
+				_, err = c.chatSvc.RemoveReaction(context.Background(), c.userID, msg.ID, msg.Emoji)
+			}
+			// Success is confirmed by the "reaction" event
+			if err != nil {
+				c.send <- ServerEvent{Type: "error", Data: reactionError(err)}
 			}
 
-			log.Printf("[CHAT DEBUG] Sending message to recipient %d", msg.To)
-			chatHub.sendToUser(msg.To, out)
-			log.Printf("[CHAT DEBUG] Echoing message back to sender %d", c.userID)
-			chatHub.sendToUser(c.userID, out) // echo so sender UI updates instantly
+		case "delivered":
+			// Acknowledges msg.ID and everything before it from the same
+			// sender; repeated acks are no-ops. Acks have their own bucket so
+			// a burst of incoming messages does not use up the sender's.
+			if !c.allow(limitWSAck) {
+				continue
+			}
+			if err := c.chatSvc.MarkDelivered(context.Background(), c.userID, msg.ID); err != nil {
+				if errors.Is(err, ErrNotFound) {
+					c.send <- ServerEvent{Type: "error", Data: "message not found"}
+				} else {
+					c.send <- ServerEvent{Type: "error", Data: "cannot acknowledge message"}
+				}
+			}
 
 		case "typing":
-			log.Printf("[CHAT DEBUG] Processing typing indicator from %d to %d", c.userID, msg.To)
-			chatHub.sendToUser(msg.To, ServerEvent{Type: "typing", From: c.userID})
+			if msg.To <= 0 || msg.To == c.userID {
+				c.send <- ServerEvent{Type: "error", Data: "invalid typing target"}
+				continue
+			}
+			if msg.Typing != nil && !*msg.Typing {
+				typingStatus.Stop(c.userID, msg.To)
+				continue
+			}
+			if c.blockSvc != nil {
+				if blocked, err := c.blockSvc.IsBlocked(context.Background(), c.userID, msg.To); err != nil || blocked {
+					continue // silently dropped, the peer must not notice the block
+				}
+			}
+			// The chat may not exist yet (no message exchanged); the indicator
+			// is still delivered over WS, GraphQL subscribers need a chat ID.
+			chatID, err := c.chatSvc.ChatIDForPeer(context.Background(), c.userID, msg.To)
+			if err != nil && !errors.Is(err, ErrNotFound) {
+				c.send <- ServerEvent{Type: "error", Data: "cannot send typing"}
+				continue
+			}
+			typingStatus.Start(chatID, c.userID, msg.To)
+
+		case "auth":
+			// Token renewal for a connection whose token is about to expire
+			userID, ok := parseUserIDFromJWT(msg.Token)
+			if !ok || userID != c.userID {
+				c.send <- ServerEvent{Type: "error", Data: "invalid token"}
+				continue
+			}
+			claims, _ := parseToken(msg.Token)
+			select {
+			case c.reauth <- claims.ExpiresAt:
+			default:
+				// A renewal is already pending; replace it
+				select {
+				case <-c.reauth:
+				default:
+				}
+				c.reauth <- claims.ExpiresAt
+			}
+			c.send <- ServerEvent{Type: "info", Data: "reauthenticated"}
 
 		default:
 			log.Printf("[CHAT DEBUG] Unknown message type from %d: %s", c.userID, msg.Type)
@@ -240,16 +566,106 @@ func clientReader(c *Client) {
 	}
 }
 
-// clientWriter pumps outgoing events to the WebSocket connection.
+// allow takes a token from the user's bucket for the action. Every frame
+// that writes to the database or pushes an event to the peer draws from
+// ws_send, or ws_ack for delivery acks. When it is empty the client gets a
+// rate_limited error.
+func (c *Client) allow(action string) bool {
+	if err := c.limit(action); err != nil {
+		c.send <- ServerEvent{Type: "error", Data: err.Error()}
+		return false
+	}
+	return true
+}
+
+// limit takes a token from the user's bucket for the action
+func (c *Client) limit(action string) error {
+	return rateLimiter.Allow(context.Background(), action, strconv.Itoa(c.userID))
+}
+
+// messageChangeError describes why an edit or delete failed
+func messageChangeError(op string, err error) string {
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return "message not found"
+	case errors.Is(err, ErrNotSender):
+		return "only the sender can " + op + " a message"
+	case errors.Is(err, ErrMessageDeleted):
+		return "message was deleted"
+	case errors.Is(err, ErrEmptyMessage):
+		return "message body cannot be empty"
+	}
+	return "cannot " + op + " message"
+}
+
+// sendFailure describes why a message was not sent. A block reads the same
+// as a missing connection.
+func sendFailure(clientMsgID string, err error) SendFailure {
+	f := SendFailure{ClientMsgID: clientMsgID, Code: "send_failed", Error: "cannot send message"}
+	switch {
+	case errors.Is(err, ErrBlocked), errors.Is(err, ErrNoConnection):
+		f.Code, f.Error = "no_connection", "no accepted connection with this user"
+	case errors.Is(err, ErrEmptyMessage):
+		f.Code, f.Error = ErrEmptyMessage.Error(), "message body cannot be empty"
+	case errors.Is(err, ErrBadReply):
+		f.Code, f.Error = ErrBadReply.Error(), "reply must quote a message in the same chat"
+	case errors.Is(err, ErrMessageDeleted):
+		f.Code, f.Error = ErrMessageDeleted.Error(), "cannot reply to a deleted message"
+	case errors.Is(err, ErrBadClientMsgID):
+		f.Code, f.Error = ErrBadClientMsgID.Error(), "client_msg_id must be at most 64 printable characters and not reused in another chat"
+	}
+	if rl, ok := asRateLimitError(err); ok {
+		f.Code, f.Error, f.RetryAfter = rl.Code, "too many messages, slow down", rl.RetryAfterSeconds()
+	}
+	return f
+}
+
+// reactionError describes why a reaction failed
+func reactionError(err error) string {
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return "message not found"
+	case errors.Is(err, ErrMessageDeleted):
+		return "message was deleted"
+	case errors.Is(err, ErrBadReaction):
+		return "a reaction must be a single emoji"
+	}
+	return "cannot react to message"
+}
+
+// clientWriter pumps outgoing events to the WebSocket connection. It also
+// watches the token expiry: the client gets a "reauth" event when its token
+// expires and the connection is closed if no "auth" frame follows in time.
 func clientWriter(c *Client) {
 	ticker := time.NewTicker(30 * time.Second)
+	expiry := time.NewTimer(time.Until(c.tokenExp))
+	if c.tokenExp.IsZero() {
+		expiry.Stop()
+	}
 	defer func() {
 		ticker.Stop()
+		expiry.Stop()
 		c.conn.Close()
 	}()
 
+	expired := false
 	for {
 		select {
+		case exp := <-c.reauth:
+			expiry.Reset(time.Until(exp))
+			expired = false
+		case <-expiry.C:
+			c.conn.SetWriteDeadline(time.Now().Add(10 * time.Second))
+			if expired {
+				_ = c.conn.WriteMessage(websocket.CloseMessage,
+					websocket.FormatCloseMessage(websocket.ClosePolicyViolation, "token expired"))
+				return
+			}
+			if err := c.conn.WriteJSON(ServerEvent{Type: "reauth", Data: "token expired"}); err != nil {
+				return
+			}
+			expired = true
+			expiry.Reset(wsReauthGrace)
 		case evt, ok := <-c.send:
 			if !ok {
 				return
@@ -311,9 +727,138 @@ func getChatHistoryHandler(db *sql.DB) http.HandlerFunc {
 	})
 }
 
+// graphMessageService adapts ChatService to the GraphQL layer
+type graphMessageService struct {
+	ChatService
+}
+
+var _ graph.MessageService = graphMessageService{}
+
+func (g graphMessageService) SendMessage(ctx context.Context, userID, targetID int, content string, replyToID int64, clientMsgID string) (*model.ChatMessage, error) {
+	msg, err := g.ChatService.SendMessage(ctx, userID, targetID, content, replyToID, clientMsgID)
+	if err != nil {
+		return nil, err
+	}
+	return toGraphMessage(msg), nil
+}
+
+func (g graphMessageService) EditMessage(ctx context.Context, userID int, messageID int64, content string) (*model.ChatMessage, error) {
+	msg, err := g.ChatService.EditMessage(ctx, userID, messageID, content)
+	if err != nil {
+		return nil, err
+	}
+	reactions, err := g.GetReactions(ctx, userID, []int64{messageID})
+	if err != nil {
+		return nil, err
+	}
+	msg.Reactions = reactions[messageID]
+	return toGraphMessage(msg), nil
+}
+
+func (g graphMessageService) DeleteMessage(ctx context.Context, userID int, messageID int64) (*model.ChatMessage, error) {
+	msg, err := g.ChatService.DeleteMessage(ctx, userID, messageID)
+	if err != nil {
+		return nil, err
+	}
+	return toGraphMessage(msg), nil
+}
+
+func (g graphMessageService) MessageEdits(ctx context.Context, userID int, messageID int64) ([]*model.MessageEdit, error) {
+	edits, err := g.GetEdits(ctx, userID, messageID)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*model.MessageEdit, len(edits))
+	for i, e := range edits {
+		out[i] = &model.MessageEdit{Content: e.Body, EditedAt: e.EditedAt.Format(time.RFC3339)}
+	}
+	return out, nil
+}
+
+func (g graphMessageService) AddReaction(ctx context.Context, userID int, messageID int64, emoji string) ([]*model.Reaction, error) {
+	reactions, err := g.ChatService.AddReaction(ctx, userID, messageID, emoji)
+	if err != nil {
+		return nil, err
+	}
+	return toGraphReactions(reactions), nil
+}
+
+func (g graphMessageService) RemoveReaction(ctx context.Context, userID int, messageID int64, emoji string) ([]*model.Reaction, error) {
+	reactions, err := g.ChatService.RemoveReaction(ctx, userID, messageID, emoji)
+	if err != nil {
+		return nil, err
+	}
+	return toGraphReactions(reactions), nil
+}
+
+func (g graphMessageService) Reactions(ctx context.Context, userID int, messageIDs []int64) (map[int64][]*model.Reaction, error) {
+	byMsg, err := g.GetReactions(ctx, userID, messageIDs)
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[int64][]*model.Reaction, len(byMsg))
+	for id, reactions := range byMsg {
+		out[id] = toGraphReactions(reactions)
+	}
+	return out, nil
+}
+
+func (g graphMessageService) Previews(ctx context.Context, userID int, messageIDs []int64) (map[int64]*model.MessagePreview, error) {
+	previews, err := g.GetPreviews(ctx, userID, messageIDs)
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[int64]*model.MessagePreview, len(previews))
+	for id, p := range previews {
+		out[id] = toGraphPreview(p)
+	}
+	return out, nil
+}
+
+func toGraphPreview(p MessagePreview) *model.MessagePreview {
+	return &model.MessagePreview{
+		ID:       strconv.FormatInt(p.ID, 10),
+		SenderID: strconv.Itoa(p.From),
+		Content:  p.Body,
+		Deleted:  p.Deleted,
+	}
+}
+
+func toGraphReactions(reactions []Reaction) []*model.Reaction {
+	out := make([]*model.Reaction, len(reactions))
+	for i, rc := range reactions {
+		out[i] = &model.Reaction{Emoji: rc.Emoji, Count: rc.Count, ReactedByMe: rc.Mine}
+	}
+	return out
+}
+
+func toGraphMessage(msg ChatMessage) *model.ChatMessage {
+	out := &model.ChatMessage{
+		ID:          strconv.FormatInt(msg.ID, 10),
+		ChatID:      strconv.Itoa(msg.ChatID),
+		SenderID:    strconv.Itoa(msg.From),
+		Content:     msg.Body,
+		CreatedAt:   msg.Ts.Format(time.RFC3339),
+		EditedAt:    formatTimePtr(msg.EditedAt),
+		DeletedAt:   formatTimePtr(msg.DeletedAt),
+		DeliveredAt: formatTimePtr(msg.DeliveredAt),
+		ReadAt:      formatTimePtr(msg.ReadAt),
+		Reactions:   toGraphReactions(msg.Reactions),
+	}
+	if msg.ReplyTo != nil {
+		out.ReplyTo = toGraphPreview(*msg.ReplyTo)
+	}
+	if msg.ClientMsgID != "" {
+		id := msg.ClientMsgID
+		out.ClientMsgID = &id
+	}
+	return out
+}
+
 // Backward-compatibility wrappers called by tests that import these directly.
 func saveChatMsg(ctx context.Context, db *sql.DB, fromUserID, toUserID int, content string) (int64, int, time.Time, error) {
-	return NewChatRepository(db).SaveChatMsg(ctx, fromUserID, toUserID, content)
+	msg, _, err := NewChatRepository(db).SaveChatMsg(ctx, fromUserID, toUserID, content, 0, "")
+	return msg.ID, msg.ChatID, msg.Ts, err
 }
 
 func getChatMessages(ctx context.Context, db *sql.DB, userID, otherUserID, limit int, before *time.Time) ([]ChatMessage, error) {
@@ -324,11 +869,12 @@ func getChatMessages(ctx context.Context, db *sql.DB, userID, otherUserID, limit
 	}
 	chatID, err := repo.GetChatIDForPair(ctx, userID, otherUserID)
 	if err == nil {
-		_ = repo.MarkChatAsRead(chatID, userID, otherUserID)
+		_, _ = repo.MarkChatAsRead(chatID, userID, otherUserID)
 	}
 	return msgs, nil
 }
 
 func markChatAsRead(db *sql.DB, chatID, readerUserID, senderUserID int) error {
-	return NewChatRepository(db).MarkChatAsRead(chatID, readerUserID, senderUserID)
+	_, err := NewChatRepository(db).MarkChatAsRead(chatID, readerUserID, senderUserID)
+	return err
 }
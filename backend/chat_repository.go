@@ -3,17 +3,52 @@ package main
 import (
 	"context"
 	"database/sql"
-	"fmt"
+	"sort"
 	"time"
+
+	"github.com/lib/pq"
 )
 
 // ChatRepository abstracts all raw SQL for the chat domain.
 type ChatRepository interface {
-	SaveChatMsg(ctx context.Context, fromUserID, toUserID int, content string) (msgID int64, chatID int, ts time.Time, err error)
+	// SaveChatMsg stores a message, optionally quoting replyToID (0 for none),
+	// which must be a message of the same chat that was not deleted. A
+	// non-empty clientMsgID the sender used before returns that message
+	// instead, reporting that nothing was created.
+	SaveChatMsg(ctx context.Context, fromUserID, toUserID int, content string, replyToID int64, clientMsgID string) (msg ChatMessage, created bool, err error)
 	GetChatMessages(ctx context.Context, userID, otherUserID, limit int, before *time.Time) ([]ChatMessage, error)
-	MarkChatAsRead(chatID, readerUserID, senderUserID int) error
+	// MarkChatAsRead marks senderUserID's messages in the chat as read and
+	// returns the ones that were not read before
+	MarkChatAsRead(chatID, readerUserID, senderUserID int) (Receipt, error)
+	// MarkDelivered records that recipientID's client received upToMsgID and
+	// every earlier message from the same sender; it returns the messages
+	// that were not delivered before
+	MarkDelivered(ctx context.Context, recipientID int, upToMsgID int64) (Receipt, error)
 	GetChatSummaries(ctx context.Context, userID int) ([]ChatPeerSummary, error)
 	GetChatIDForPair(ctx context.Context, userID, peerID int) (int, error)
+	IsChatMember(ctx context.Context, userID, chatID int) (bool, error)
+	// GetChatPeer returns the other participant of one of userID's chats
+	GetChatPeer(ctx context.Context, userID, chatID int) (int, error)
+
+	// EditChatMsg replaces the content of userID's message, keeping the old
+	// content in the edit history. It reports whether that changed anything.
+	EditChatMsg(ctx context.Context, userID int, msgID int64, content string) (ChatMessage, bool, error)
+	// DeleteChatMsg turns userID's message into a tombstone for both
+	// participants
+	DeleteChatMsg(ctx context.Context, userID int, msgID int64) (ChatMessage, error)
+	// SetReaction adds (on) or removes userID's emoji reaction to a message in
+	// one of their chats. It reports whether that changed anything.
+	SetReaction(ctx context.Context, userID int, msgID int64, emoji string, on bool) (ChatMessage, bool, error)
+	// GetReactions counts the reactions to the messages, per emoji in the
+	// order they were first used, marking userID's own
+	GetReactions(ctx context.Context, userID int, msgIDs []int64) (map[int64][]Reaction, error)
+	// GetMessagePreviews returns quote previews of the messages in userID's
+	// chats, keyed by message ID
+	GetMessagePreviews(ctx context.Context, userID int, msgIDs []int64) (map[int64]MessagePreview, error)
+	// GetMessageEdits returns the edit history of a message in one of
+	// userID's chats, oldest first: ErrNotFound for messages outside them and
+	// ErrMessageDeleted for deleted ones.
+	GetMessageEdits(ctx context.Context, userID int, msgID int64) ([]MessageEdit, error)
 }
 
 type sqlChatRepo struct {
@@ -24,10 +59,10 @@ func NewChatRepository(db *sql.DB) ChatRepository {
 	return &sqlChatRepo{db: db}
 }
 
-func (r *sqlChatRepo) SaveChatMsg(ctx context.Context, fromUserID, toUserID int, content string) (int64, int, time.Time, error) {
+func (r *sqlChatRepo) SaveChatMsg(ctx context.Context, fromUserID, toUserID int, content string, replyToID int64, clientMsgID string) (ChatMessage, bool, error) {
 	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
-		return 0, 0, time.Time{}, err
+		return ChatMessage{}, false, err
 	}
 	defer func() {
 		if err != nil {
@@ -37,7 +72,32 @@ func (r *sqlChatRepo) SaveChatMsg(ctx context.Context, fromUserID, toUserID int,
 		}
 	}()
 
-	// 1) Verify an accepted connection exists
+	// 1) A retried send returns the message stored the first time
+	if clientMsgID != "" {
+		var m ChatMessage
+		m, err = findClientMessage(ctx, tx, fromUserID, clientMsgID)
+		if err == nil {
+			if m.To != toUserID {
+				// Not a retry: the ID was already used in another chat
+				err = ErrBadClientMsgID
+				return ChatMessage{}, false, err
+			}
+			return m, false, nil
+		}
+		if err != ErrNotFound {
+			return ChatMessage{}, false, err
+		}
+	}
+
+	// 2) Verify neither side blocked the other and an accepted connection exists
+	blocked, err := isBlocked(ctx, tx, fromUserID, toUserID)
+	if err != nil {
+		return ChatMessage{}, false, err
+	}
+	if blocked {
+		err = ErrBlocked
+		return ChatMessage{}, false, err
+	}
 	var ok int
 	err = tx.QueryRowContext(ctx, `
 		SELECT 1
@@ -48,12 +108,12 @@ func (r *sqlChatRepo) SaveChatMsg(ctx context.Context, fromUserID, toUserID int,
 	`, fromUserID, toUserID).Scan(&ok)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return 0, 0, time.Time{}, fmt.Errorf("no accepted connection")
+			err = ErrNoConnection
 		}
-		return 0, 0, time.Time{}, err
+		return ChatMessage{}, false, err
 	}
 
-	// 2) Fetch or create a chat row
+	// 3) Fetch or create a chat row
 	var chatID int
 	err = tx.QueryRowContext(ctx, `
 		SELECT id
@@ -79,34 +139,95 @@ func (r *sqlChatRepo) SaveChatMsg(ctx context.Context, fromUserID, toUserID int,
 		}
 	}
 	if err != nil {
-		return 0, 0, time.Time{}, err
+		return ChatMessage{}, false, err
+	}
+
+	// 4) A quoted message must belong to this chat and still be there
+	if replyToID != 0 {
+		var deleted bool
+		err = tx.QueryRowContext(ctx, `
+			SELECT deleted_at IS NOT NULL
+			FROM messages
+			WHERE id = $1 AND chat_id = $2
+		`, replyToID, chatID).Scan(&deleted)
+		if err == sql.ErrNoRows {
+			err = ErrBadReply
+		} else if err == nil && deleted {
+			err = ErrMessageDeleted
+		}
+		if err != nil {
+			return ChatMessage{}, false, err
+		}
 	}
 
-	// 3) Insert message
-	var msgID int64
-	var createdAt time.Time
+	// 5) Insert message
+	m := ChatMessage{
+		Type:        "message",
+		ChatID:      chatID,
+		From:        fromUserID,
+		To:          toUserID,
+		Body:        content,
+		ReplyToID:   replyToID,
+		ClientMsgID: clientMsgID,
+	}
 	err = tx.QueryRowContext(ctx, `
-		INSERT INTO messages (chat_id, sender_id, content)
-		VALUES ($1, $2, $3)
+		INSERT INTO messages (chat_id, sender_id, content, reply_to_id, client_msg_id)
+		VALUES ($1, $2, $3, NULLIF($4::int, 0), NULLIF($5, ''))
+		ON CONFLICT (sender_id, client_msg_id) WHERE client_msg_id IS NOT NULL DO NOTHING
 		RETURNING id, created_at
-	`, chatID, fromUserID, content).Scan(&msgID, &createdAt)
+	`, chatID, fromUserID, content, replyToID, clientMsgID).Scan(&m.ID, &m.Ts)
+	if err == sql.ErrNoRows {
+		// A concurrent send with the same client ID won the race
+		m, err = findClientMessage(ctx, tx, fromUserID, clientMsgID)
+		if err != nil {
+			return ChatMessage{}, false, err
+		}
+		if m.To != toUserID {
+			err = ErrBadClientMsgID
+			return ChatMessage{}, false, err
+		}
+		return m, false, nil
+	}
 	if err != nil {
-		return 0, 0, time.Time{}, err
+		return ChatMessage{}, false, err
 	}
 
-	// 4) Update unread flags
+	// 6) Update unread flags
 	_, err = tx.ExecContext(ctx, `
 		UPDATE chats c
 		SET last_message_at = $3,
 			unread_for_user1 = CASE WHEN $2 = c.user2_id THEN TRUE ELSE unread_for_user1 END,
 			unread_for_user2 = CASE WHEN $2 = c.user1_id THEN TRUE ELSE unread_for_user2 END
 		WHERE c.id = $1
-	`, chatID, fromUserID, createdAt)
+	`, chatID, fromUserID, m.Ts)
 	if err != nil {
-		return 0, 0, time.Time{}, err
+		return ChatMessage{}, false, err
 	}
 
-	return msgID, chatID, createdAt, nil
+	return m, true, nil
+}
+
+// findClientMessage returns the message senderID sent with clientMsgID, or
+// ErrNotFound
+func findClientMessage(ctx context.Context, tx *sql.Tx, senderID int, clientMsgID string) (ChatMessage, error) {
+	m := ChatMessage{Type: "message", From: senderID, ClientMsgID: clientMsgID}
+	var replyToID sql.NullInt64
+	err := tx.QueryRowContext(ctx, `
+		SELECT m.id, m.chat_id, CASE WHEN c.user1_id = $1 THEN c.user2_id ELSE c.user1_id END,
+			CASE WHEN m.deleted_at IS NULL THEN m.content ELSE '' END, m.created_at,
+			m.edited_at, m.deleted_at, m.reply_to_id
+		FROM messages m
+		JOIN chats c ON c.id = m.chat_id
+		WHERE m.sender_id = $1 AND m.client_msg_id = $2
+	`, senderID, clientMsgID).Scan(&m.ID, &m.ChatID, &m.To, &m.Body, &m.Ts, &m.EditedAt, &m.DeletedAt, &replyToID)
+	if err == sql.ErrNoRows {
+		return ChatMessage{}, ErrNotFound
+	}
+	if err != nil {
+		return ChatMessage{}, err
+	}
+	m.ReplyToID = replyToID.Int64
+	return m, nil
 }
 
 func (r *sqlChatRepo) GetChatMessages(ctx context.Context, userID, otherUserID, limit int, before *time.Time) ([]ChatMessage, error) {
@@ -125,9 +246,9 @@ func (r *sqlChatRepo) GetChatMessages(ctx context.Context, userID, otherUserID,
 		return nil, err
 	}
 
-	// 2) Fetch messages
+	// 2) Fetch messages; deleted ones come back as tombstones without content
 	q := `
-		SELECT id, sender_id, content, created_at
+		SELECT id, sender_id, CASE WHEN deleted_at IS NULL THEN content ELSE '' END, created_at, edited_at, deleted_at, reply_to_id, delivered_at, read_at
 		FROM messages
 		WHERE chat_id = $1
 			AND ($2::timestamptz IS NULL OR created_at < $2)
@@ -151,33 +272,76 @@ func (r *sqlChatRepo) GetChatMessages(ctx context.Context, userID, otherUserID,
 		var senderID int
 		var body string
 		var createdAt time.Time
-		if err := rows.Scan(&msgID, &senderID, &body, &createdAt); err != nil {
+		var editedAt, deletedAt, deliveredAt, readAt *time.Time
+		var replyToID sql.NullInt64
+		if err := rows.Scan(&msgID, &senderID, &body, &createdAt, &editedAt, &deletedAt, &replyToID, &deliveredAt, &readAt); err != nil {
 			return nil, err
 		}
 		msgs = append(msgs, ChatMessage{
-			ID:     msgID,
-			Type:   "message",
-			ChatID: chatID,
-			From:   senderID,
-			Body:   body,
-			Ts:     createdAt,
+			ID:          msgID,
+			Type:        "message",
+			ChatID:      chatID,
+			From:        senderID,
+			Body:        body,
+			Ts:          createdAt,
+			EditedAt:    editedAt,
+			DeletedAt:   deletedAt,
+			ReplyToID:   replyToID.Int64,
+			DeliveredAt: deliveredAt,
+			ReadAt:      readAt,
 		})
 	}
 	if err := rows.Err(); err != nil {
 		return nil, err
 	}
 
+	// 3) Attach reaction counts
+	ids := make([]int64, len(msgs))
+	for i, m := range msgs {
+		ids[i] = m.ID
+	}
+	reactions, err := r.GetReactions(ctx, userID, ids)
+	if err != nil {
+		return nil, err
+	}
+	for i := range msgs {
+		msgs[i].Reactions = reactions[msgs[i].ID]
+	}
+
+	// 4) Attach quote previews; they are read live, so a quoted message
+	// that was deleted since shows up as a tombstone
+	var quoted []int64
+	for _, m := range msgs {
+		if m.ReplyToID != 0 {
+			quoted = append(quoted, m.ReplyToID)
+		}
+	}
+	previews, err := r.GetMessagePreviews(ctx, userID, quoted)
+	if err != nil {
+		return nil, err
+	}
+	for i := range msgs {
+		if p, ok := previews[msgs[i].ReplyToID]; ok {
+			msgs[i].ReplyTo = &p
+		}
+	}
+
 	return msgs, nil
 }
 
-func (r *sqlChatRepo) MarkChatAsRead(chatID, readerUserID, senderUserID int) error {
-	_, err := r.db.Exec(`
+func (r *sqlChatRepo) MarkChatAsRead(chatID, readerUserID, senderUserID int) (Receipt, error) {
+	rc := Receipt{ChatID: chatID, SenderID: senderUserID, RecipientID: readerUserID}
+	rows, err := r.db.Query(`
 		UPDATE messages
-		SET is_read = TRUE
+		SET is_read = TRUE, read_at = NOW(), delivered_at = COALESCE(delivered_at, NOW())
 		WHERE chat_id = $1 AND sender_id = $2 AND is_read IS FALSE
+		RETURNING id, read_at
 	`, chatID, senderUserID)
 	if err != nil {
-		return err
+		return Receipt{}, err
+	}
+	if err := scanReceipt(rows, &rc); err != nil {
+		return Receipt{}, err
 	}
 
 	_, err = r.db.Exec(`
@@ -186,7 +350,56 @@ func (r *sqlChatRepo) MarkChatAsRead(chatID, readerUserID, senderUserID int) err
 			unread_for_user2 = CASE WHEN $1 = c.user2_id THEN FALSE ELSE unread_for_user2 END
 		WHERE c.id = $2
 	`, readerUserID, chatID)
-	return err
+	if err != nil {
+		return Receipt{}, err
+	}
+	return rc, nil
+}
+
+func (r *sqlChatRepo) MarkDelivered(ctx context.Context, recipientID int, upToMsgID int64) (Receipt, error) {
+	// The acknowledged message must be one recipientID received
+	rc := Receipt{RecipientID: recipientID}
+	err := r.db.QueryRowContext(ctx, `
+		SELECT m.chat_id, m.sender_id
+		FROM messages m
+		JOIN chats c ON c.id = m.chat_id
+		WHERE m.id = $1 AND m.sender_id <> $2 AND (c.user1_id = $2 OR c.user2_id = $2)
+	`, upToMsgID, recipientID).Scan(&rc.ChatID, &rc.SenderID)
+	if err == sql.ErrNoRows {
+		return Receipt{}, ErrNotFound
+	}
+	if err != nil {
+		return Receipt{}, err
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		UPDATE messages
+		SET delivered_at = NOW()
+		WHERE chat_id = $1 AND sender_id = $2 AND id <= $3 AND delivered_at IS NULL
+		RETURNING id, delivered_at
+	`, rc.ChatID, rc.SenderID, upToMsgID)
+	if err != nil {
+		return Receipt{}, err
+	}
+	if err := scanReceipt(rows, &rc); err != nil {
+		return Receipt{}, err
+	}
+	return rc, nil
+}
+
+// scanReceipt collects the (id, timestamp) rows of a receipt update, oldest
+// message first
+func scanReceipt(rows *sql.Rows, rc *Receipt) error {
+	defer rows.Close()
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id, &rc.At); err != nil {
+			return err
+		}
+		rc.MessageIDs = append(rc.MessageIDs, id)
+	}
+	sort.Slice(rc.MessageIDs, func(i, j int) bool { return rc.MessageIDs[i] < rc.MessageIDs[j] })
+	return rows.Err()
 }
 
 func (r *sqlChatRepo) GetChatSummaries(ctx context.Context, userID int) ([]ChatPeerSummary, error) {
@@ -207,7 +420,7 @@ chat_pairs AS (
 ),
 unreads AS (
   SELECT cp.peer_id,
-         COALESCE(SUM(CASE WHEN m.is_read = FALSE AND m.sender_id = cp.peer_id THEN 1 ELSE 0 END), 0) AS unread_count
+         COALESCE(SUM(CASE WHEN m.is_read = FALSE AND m.sender_id = cp.peer_id AND m.deleted_at IS NULL THEN 1 ELSE 0 END), 0) AS unread_count
   FROM chat_pairs cp
   LEFT JOIN messages m ON m.chat_id = cp.chat_id
   GROUP BY cp.peer_id
@@ -268,3 +481,281 @@ func (r *sqlChatRepo) GetChatIDForPair(ctx context.Context, userID, peerID int)
 	}
 	return chatID, err
 }
+
+func (r *sqlChatRepo) GetChatPeer(ctx context.Context, userID, chatID int) (int, error) {
+	var peerID int
+	err := r.db.QueryRowContext(ctx, `
+		SELECT CASE WHEN user1_id = $1 THEN user2_id ELSE user1_id END
+		FROM chats
+		WHERE id = $2 AND (user1_id = $1 OR user2_id = $1)
+	`, userID, chatID).Scan(&peerID)
+	if err == sql.ErrNoRows {
+		return 0, ErrNotFound
+	}
+	return peerID, err
+}
+
+func (r *sqlChatRepo) IsChatMember(ctx context.Context, userID, chatID int) (bool, error) {
+	var member bool
+	err := r.db.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM chats
+			WHERE id = $1 AND (user1_id = $2 OR user2_id = $2)
+		)
+	`, chatID, userID).Scan(&member)
+	return member, err
+}
+
+// lockChatMessage locks a message in one of userID's chats. It returns
+// ErrNotFound for messages outside them and ErrMessageDeleted for
+// tombstones.
+func lockChatMessage(ctx context.Context, tx *sql.Tx, userID int, msgID int64) (ChatMessage, error) {
+	m := ChatMessage{ID: msgID, Type: "message"}
+	var user1, user2 int
+	err := tx.QueryRowContext(ctx, `
+		SELECT m.chat_id, m.sender_id, m.content, m.created_at, m.edited_at, m.deleted_at, c.user1_id, c.user2_id
+		FROM messages m
+		JOIN chats c ON c.id = m.chat_id
+		WHERE m.id = $1 AND (c.user1_id = $2 OR c.user2_id = $2)
+		FOR UPDATE OF m
+	`, msgID, userID).Scan(&m.ChatID, &m.From, &m.Body, &m.Ts, &m.EditedAt, &m.DeletedAt, &user1, &user2)
+	if err == sql.ErrNoRows {
+		return ChatMessage{}, ErrNotFound
+	}
+	if err != nil {
+		return ChatMessage{}, err
+	}
+	if m.DeletedAt != nil {
+		return ChatMessage{}, ErrMessageDeleted
+	}
+	m.To = user1
+	if user1 == m.From {
+		m.To = user2
+	}
+	return m, nil
+}
+
+// lockOwnMessage is lockChatMessage for a change by the sender; it returns
+// ErrNotSender when the peer sent the message
+func lockOwnMessage(ctx context.Context, tx *sql.Tx, userID int, msgID int64) (ChatMessage, error) {
+	m, err := lockChatMessage(ctx, tx, userID, msgID)
+	if err != nil {
+		return ChatMessage{}, err
+	}
+	if m.From != userID {
+		return ChatMessage{}, ErrNotSender
+	}
+	return m, nil
+}
+
+func (r *sqlChatRepo) EditChatMsg(ctx context.Context, userID int, msgID int64, content string) (ChatMessage, bool, error) {
+	var m ChatMessage
+	var changed bool
+	err := withTx(ctx, r.db, func(tx *sql.Tx) error {
+		var err error
+		if m, err = lockOwnMessage(ctx, tx, userID, msgID); err != nil {
+			return err
+		}
+		blocked, err := isBlocked(ctx, tx, userID, m.To)
+		if err != nil {
+			return err
+		}
+		if blocked {
+			return ErrBlocked
+		}
+		if content == m.Body {
+			return nil
+		}
+
+		var editedAt time.Time
+		if err := tx.QueryRowContext(ctx, `
+			UPDATE messages SET content = $2, edited_at = NOW() WHERE id = $1
+			RETURNING edited_at
+		`, msgID, content).Scan(&editedAt); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO message_edits (message_id, content, edited_at) VALUES ($1, $2, $3)
+		`, msgID, m.Body, editedAt); err != nil {
+			return err
+		}
+		m.Body = content
+		m.EditedAt = &editedAt
+		changed = true
+		return nil
+	})
+	if err != nil {
+		return ChatMessage{}, false, err
+	}
+	return m, changed, nil
+}
+
+func (r *sqlChatRepo) DeleteChatMsg(ctx context.Context, userID int, msgID int64) (ChatMessage, error) {
+	var m ChatMessage
+	err := withTx(ctx, r.db, func(tx *sql.Tx) error {
+		var err error
+		if m, err = lockOwnMessage(ctx, tx, userID, msgID); err != nil {
+			return err
+		}
+		// The content stays for moderators; participants only see the tombstone
+		var deletedAt time.Time
+		if err := tx.QueryRowContext(ctx, `
+			UPDATE messages SET deleted_at = NOW() WHERE id = $1
+			RETURNING deleted_at
+		`, msgID).Scan(&deletedAt); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `DELETE FROM message_reactions WHERE message_id = $1`, msgID); err != nil {
+			return err
+		}
+		m.Body = ""
+		m.DeletedAt = &deletedAt
+		return nil
+	})
+	if err != nil {
+		return ChatMessage{}, err
+	}
+	return m, nil
+}
+
+func (r *sqlChatRepo) GetMessageEdits(ctx context.Context, userID int, msgID int64) ([]MessageEdit, error) {
+	// Tell missing and deleted messages apart from ones never edited
+	var deleted bool
+	err := r.db.QueryRowContext(ctx, `
+		SELECT m.deleted_at IS NOT NULL
+		FROM messages m
+		JOIN chats c ON c.id = m.chat_id
+		WHERE m.id = $1 AND (c.user1_id = $2 OR c.user2_id = $2)
+	`, msgID, userID).Scan(&deleted)
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if deleted {
+		return nil, ErrMessageDeleted
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT content, edited_at
+		FROM message_edits
+		WHERE message_id = $1
+		ORDER BY edited_at, id
+	`, msgID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	edits := []MessageEdit{}
+	for rows.Next() {
+		var e MessageEdit
+		if err := rows.Scan(&e.Body, &e.EditedAt); err != nil {
+			return nil, err
+		}
+		edits = append(edits, e)
+	}
+	return edits, rows.Err()
+}
+
+func (r *sqlChatRepo) SetReaction(ctx context.Context, userID int, msgID int64, emoji string, on bool) (ChatMessage, bool, error) {
+	var m ChatMessage
+	var changed bool
+	err := withTx(ctx, r.db, func(tx *sql.Tx) error {
+		var err error
+		if m, err = lockChatMessage(ctx, tx, userID, msgID); err != nil {
+			return err
+		}
+		var res sql.Result
+		if on {
+			peerID := m.From
+			if peerID == userID {
+				peerID = m.To
+			}
+			blocked, err := isBlocked(ctx, tx, userID, peerID)
+			if err != nil {
+				return err
+			}
+			if blocked {
+				return ErrBlocked
+			}
+			res, err = tx.ExecContext(ctx, `
+				INSERT INTO message_reactions (message_id, user_id, emoji) VALUES ($1, $2, $3)
+				ON CONFLICT DO NOTHING
+			`, msgID, userID, emoji)
+			if err != nil {
+				return err
+			}
+		} else {
+			res, err = tx.ExecContext(ctx, `
+				DELETE FROM message_reactions WHERE message_id = $1 AND user_id = $2 AND emoji = $3
+			`, msgID, userID, emoji)
+			if err != nil {
+				return err
+			}
+		}
+		n, err := res.RowsAffected()
+		changed = n > 0
+		return err
+	})
+	if err != nil {
+		return ChatMessage{}, false, err
+	}
+	return m, changed, nil
+}
+
+func (r *sqlChatRepo) GetReactions(ctx context.Context, userID int, msgIDs []int64) (map[int64][]Reaction, error) {
+	reactions := make(map[int64][]Reaction)
+	if len(msgIDs) == 0 {
+		return reactions, nil
+	}
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT message_id, emoji, COUNT(*), BOOL_OR(user_id = $2)
+		FROM message_reactions
+		WHERE message_id = ANY($1::int[])
+		GROUP BY message_id, emoji
+		ORDER BY message_id, MIN(created_at), emoji
+	`, pq.Array(msgIDs), userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var msgID int64
+		var rc Reaction
+		if err := rows.Scan(&msgID, &rc.Emoji, &rc.Count, &rc.Mine); err != nil {
+			return nil, err
+		}
+		reactions[msgID] = append(reactions[msgID], rc)
+	}
+	return reactions, rows.Err()
+}
+
+func (r *sqlChatRepo) GetMessagePreviews(ctx context.Context, userID int, msgIDs []int64) (map[int64]MessagePreview, error) {
+	previews := make(map[int64]MessagePreview)
+	if len(msgIDs) == 0 {
+		return previews, nil
+	}
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT m.id, m.sender_id, CASE WHEN m.deleted_at IS NULL THEN m.content ELSE '' END, m.deleted_at IS NOT NULL
+		FROM messages m
+		JOIN chats c ON c.id = m.chat_id
+		WHERE m.id = ANY($1::int[]) AND (c.user1_id = $2 OR c.user2_id = $2)
+	`, pq.Array(msgIDs), userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var p MessagePreview
+		if err := rows.Scan(&p.ID, &p.From, &p.Body, &p.Deleted); err != nil {
+			return nil, err
+		}
+		p.Body = previewText(p.Body)
+		previews[p.ID] = p
+	}
+	return previews, rows.Err()
+}
@@ -4,40 +4,117 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"strings"
 	"time"
+	"unicode"
+
+	"gitea.kood.tech/petrkubec/match-me/backend/events"
+)
+
+var (
+	ErrNotSender      = errors.New("not_sender")
+	ErrMessageDeleted = errors.New("message_deleted")
+	ErrEmptyMessage   = errors.New("empty_message")
+	ErrBadReaction    = errors.New("invalid_reaction")
+	ErrBadReply       = errors.New("invalid_reply")
+	ErrNoConnection   = errors.New("no accepted connection")
+	ErrBadClientMsgID = errors.New("invalid_client_msg_id")
 )
 
+// maxClientMsgIDLen bounds the IDs clients tag their sends with; a UUID
+// takes 36
+const maxClientMsgIDLen = 64
+
+// replyPreviewLen is how many characters of a quoted message its preview
+// keeps
+const replyPreviewLen = 100
+
 // ChatService encapsulates all business logic for the chat domain.
 type ChatService interface {
-	SendMessage(ctx context.Context, fromID, toID int, body string) (ChatMessage, error)
+	// SendMessage stores and delivers a message. A non-zero replyToID quotes
+	// a message of the same chat; ErrBadReply when it is not one,
+	// ErrMessageDeleted when it was deleted. A clientMsgID the sender used
+	// before returns the stored message without sending it again.
+	SendMessage(ctx context.Context, fromID, toID int, body string, replyToID int64, clientMsgID string) (ChatMessage, error)
 	GetHistory(ctx context.Context, userID, otherID, limit int, before *time.Time) ([]ChatMessage, error)
 	GetSummaries(ctx context.Context, userID int) ([]ChatPeerSummary, error)
+	// MarkRead and MarkChatRead mark the peer's messages as read and send
+	// the peer a "read" receipt for the ones that were unread
 	MarkRead(ctx context.Context, userID, peerID int) error
+	MarkChatRead(ctx context.Context, userID, chatID int) error
+	// MarkDelivered acknowledges msgID and the earlier messages from the same
+	// sender, who gets a "delivered" receipt. ErrNotFound unless userID
+	// received msgID.
+	MarkDelivered(ctx context.Context, userID int, msgID int64) error
+	ChatIDForPeer(ctx context.Context, userID, peerID int) (int, error)
+
+	// EditMessage and DeleteMessage change one of userID's own messages and
+	// tell both participants. They fail with ErrNotFound outside userID's
+	// chats, ErrNotSender and ErrMessageDeleted.
+	EditMessage(ctx context.Context, userID int, msgID int64, body string) (ChatMessage, error)
+	DeleteMessage(ctx context.Context, userID int, msgID int64) (ChatMessage, error)
+	// GetEdits returns the replaced versions of a message, oldest first. It
+	// fails with ErrNotFound outside userID's chats and ErrMessageDeleted.
+	GetEdits(ctx context.Context, userID int, msgID int64) ([]MessageEdit, error)
+
+	// AddReaction and RemoveReaction change userID's reaction to a message
+	// in their chat and return the message's reactions. Both are idempotent.
+	AddReaction(ctx context.Context, userID int, msgID int64, emoji string) ([]Reaction, error)
+	RemoveReaction(ctx context.Context, userID int, msgID int64, emoji string) ([]Reaction, error)
+	GetReactions(ctx context.Context, userID int, msgIDs []int64) (map[int64][]Reaction, error)
+
+	// GetPreviews returns quote previews of messages in userID's chats
+	GetPreviews(ctx context.Context, userID int, msgIDs []int64) (map[int64]MessagePreview, error)
 }
 
 type chatService struct {
 	repo ChatRepository
 	db   *sql.DB // needed for isOnlineNow presence check
+	bus  *events.Bus
 }
 
 func NewChatService(repo ChatRepository, db *sql.DB) ChatService {
-	return &chatService{repo: repo, db: db}
+	return &chatService{repo: repo, db: db, bus: events.Default()}
 }
 
-func (s *chatService) SendMessage(ctx context.Context, fromID, toID int, body string) (ChatMessage, error) {
-	msgID, chatID, ts, err := s.repo.SaveChatMsg(ctx, fromID, toID, body)
+func (s *chatService) SendMessage(ctx context.Context, fromID, toID int, body string, replyToID int64, clientMsgID string) (ChatMessage, error) {
+	if strings.TrimSpace(body) == "" {
+		return ChatMessage{}, ErrEmptyMessage
+	}
+	if !validClientMsgID(clientMsgID) {
+		return ChatMessage{}, ErrBadClientMsgID
+	}
+	msg, created, err := s.repo.SaveChatMsg(ctx, fromID, toID, body, replyToID, clientMsgID)
 	if err != nil {
 		return ChatMessage{}, err
 	}
-	return ChatMessage{
-		ID:     msgID,
-		Type:   "message",
-		ChatID: chatID,
-		From:   fromID,
-		To:     toID,
-		Body:   body,
-		Ts:     ts,
-	}, nil
+
+	if msg.ReplyToID != 0 {
+		// The message is saved already; without a preview it is still
+		// delivered, and history shows the quote
+		previews, err := s.repo.GetMessagePreviews(ctx, fromID, []int64{msg.ReplyToID})
+		if p, ok := previews[msg.ReplyToID]; err == nil && ok {
+			msg.ReplyTo = &p
+		}
+	}
+	if !created {
+		return msg, nil // a retry: both sides got it the first time
+	}
+
+	evt := events.Message{
+		ID:          msg.ID,
+		ChatID:      msg.ChatID,
+		SenderID:    fromID,
+		RecipientID: toID,
+		Body:        body,
+		CreatedAt:   msg.Ts,
+		ClientMsgID: clientMsgID,
+	}
+	if p := msg.ReplyTo; p != nil {
+		evt.ReplyTo = &events.Quote{ID: p.ID, SenderID: p.From, Body: p.Body, Deleted: p.Deleted}
+	}
+	s.bus.PublishMessage(evt)
+	return msg, nil
 }
 
 func (s *chatService) GetHistory(ctx context.Context, userID, otherID, limit int, before *time.Time) ([]ChatMessage, error) {
@@ -49,7 +126,7 @@ func (s *chatService) GetHistory(ctx context.Context, userID, otherID, limit int
 	// Mark as read (best-effort — don't propagate errors)
 	chatID, err := s.repo.GetChatIDForPair(ctx, userID, otherID)
 	if err == nil {
-		_ = s.repo.MarkChatAsRead(chatID, userID, otherID)
+		_ = s.markRead(ctx, chatID, userID, otherID)
 	}
 
 	return msgs, nil
@@ -78,5 +155,219 @@ func (s *chatService) MarkRead(ctx context.Context, userID, peerID int) error {
 		}
 		return err
 	}
-	return s.repo.MarkChatAsRead(chatID, userID, peerID)
+	return s.markRead(ctx, chatID, userID, peerID)
+}
+
+// MarkChatRead is MarkRead for a chat ID; ErrNotFound outside userID's chats
+func (s *chatService) MarkChatRead(ctx context.Context, userID, chatID int) error {
+	peerID, err := s.repo.GetChatPeer(ctx, userID, chatID)
+	if err != nil {
+		return err
+	}
+	return s.markRead(ctx, chatID, userID, peerID)
+}
+
+func (s *chatService) markRead(ctx context.Context, chatID, userID, peerID int) error {
+	rc, err := s.repo.MarkChatAsRead(chatID, userID, peerID)
+	if err != nil {
+		return err
+	}
+	s.publishReceipt(ctx, rc, receiptRead)
+	return nil
+}
+
+func (s *chatService) MarkDelivered(ctx context.Context, userID int, msgID int64) error {
+	rc, err := s.repo.MarkDelivered(ctx, userID, msgID)
+	if err != nil {
+		return err
+	}
+	s.publishReceipt(ctx, rc, receiptDelivered)
+	return nil
+}
+
+// publishReceipt tells the sender about newly delivered or read messages.
+// The state is recorded either way, but a blocked sender is not told.
+func (s *chatService) publishReceipt(ctx context.Context, rc Receipt, status string) {
+	if len(rc.MessageIDs) == 0 {
+		return
+	}
+	if s.db != nil {
+		if blocked, err := isBlocked(ctx, s.db, rc.RecipientID, rc.SenderID); err != nil || blocked {
+			return
+		}
+	}
+	s.bus.PublishReceipt(events.Receipt{
+		ChatID:      rc.ChatID,
+		SenderID:    rc.SenderID,
+		RecipientID: rc.RecipientID,
+		MessageIDs:  rc.MessageIDs,
+		Status:      status,
+		At:          rc.At,
+	})
+}
+
+// ChatIDForPeer returns the chat between the two users, or ErrNotFound
+func (s *chatService) ChatIDForPeer(ctx context.Context, userID, peerID int) (int, error) {
+	return s.repo.GetChatIDForPair(ctx, userID, peerID)
+}
+
+func (s *chatService) EditMessage(ctx context.Context, userID int, msgID int64, body string) (ChatMessage, error) {
+	if strings.TrimSpace(body) == "" {
+		return ChatMessage{}, ErrEmptyMessage
+	}
+	msg, changed, err := s.repo.EditChatMsg(ctx, userID, msgID, body)
+	if err != nil {
+		return ChatMessage{}, err
+	}
+	if changed {
+		s.bus.PublishMessageEdited(messageChange(msg, *msg.EditedAt))
+	}
+	return msg, nil
+}
+
+func (s *chatService) DeleteMessage(ctx context.Context, userID int, msgID int64) (ChatMessage, error) {
+	msg, err := s.repo.DeleteChatMsg(ctx, userID, msgID)
+	if err != nil {
+		return ChatMessage{}, err
+	}
+	s.bus.PublishMessageDeleted(messageChange(msg, *msg.DeletedAt))
+	return msg, nil
+}
+
+func (s *chatService) GetEdits(ctx context.Context, userID int, msgID int64) ([]MessageEdit, error) {
+	return s.repo.GetMessageEdits(ctx, userID, msgID)
+}
+
+func messageChange(msg ChatMessage, at time.Time) events.MessageChange {
+	return events.MessageChange{
+		ID:          msg.ID,
+		ChatID:      msg.ChatID,
+		SenderID:    msg.From,
+		RecipientID: msg.To,
+		Body:        msg.Body,
+		CreatedAt:   msg.Ts,
+		At:          at,
+	}
+}
+
+func (s *chatService) AddReaction(ctx context.Context, userID int, msgID int64, emoji string) ([]Reaction, error) {
+	return s.setReaction(ctx, userID, msgID, emoji, true)
+}
+
+func (s *chatService) RemoveReaction(ctx context.Context, userID int, msgID int64, emoji string) ([]Reaction, error) {
+	return s.setReaction(ctx, userID, msgID, emoji, false)
+}
+
+func (s *chatService) setReaction(ctx context.Context, userID int, msgID int64, emoji string, on bool) ([]Reaction, error) {
+	if !validReaction(emoji) {
+		return nil, ErrBadReaction
+	}
+	msg, changed, err := s.repo.SetReaction(ctx, userID, msgID, emoji, on)
+	if err != nil {
+		return nil, err
+	}
+	byMsg, err := s.repo.GetReactions(ctx, userID, []int64{msgID})
+	if err != nil {
+		return nil, err
+	}
+	reactions := byMsg[msgID]
+	if reactions == nil {
+		reactions = []Reaction{}
+	}
+
+	if changed {
+		peerID := msg.From
+		if peerID == userID {
+			peerID = msg.To
+		}
+		count := 0
+		for _, rc := range reactions {
+			if rc.Emoji == emoji {
+				count = rc.Count
+			}
+		}
+		s.bus.PublishReaction(events.Reaction{
+			MessageID: msgID,
+			ChatID:    msg.ChatID,
+			UserID:    userID,
+			PeerID:    peerID,
+			Emoji:     emoji,
+			Added:     on,
+			Count:     count,
+		})
+	}
+	return reactions, nil
+}
+
+func (s *chatService) GetReactions(ctx context.Context, userID int, msgIDs []int64) (map[int64][]Reaction, error) {
+	return s.repo.GetReactions(ctx, userID, msgIDs)
+}
+
+// maxReactionLen bounds a reaction in runes; the longest emoji sequences
+// (families, flags with modifiers) stay well below it
+const maxReactionLen = 16
+
+// validReaction accepts a single emoji: symbols, joined by zero-width
+// joiners and modified by variation selectors or skin tones, keycaps (a
+// digit, # or * followed by U+20E3) and tag-sequence flags (a symbol followed
+// by tag characters). Other letters, digits and spaces are refused.
+func validReaction(emoji string) bool {
+	runes := []rune(emoji)
+	if len(runes) == 0 || len(runes) > maxReactionLen {
+		return false
+	}
+	pictograph := false
+	for i, r := range runes {
+		switch {
+		case unicode.Is(unicode.So, r):
+			pictograph = true
+		case strings.ContainsRune("0123456789#*", r):
+			if !keycapFollows(runes[i+1:]) {
+				return false
+			}
+			pictograph = true
+		case r == '\u200d' || unicode.In(r, unicode.Mn, unicode.Me, unicode.Sk):
+		case r >= '\U000e0020' && r <= '\U000e007f' && pictograph:
+		default:
+			return false
+		}
+	}
+	return pictograph
+}
+
+// keycapFollows reports whether a keycap base is followed by the combining
+// enclosing keycap, optionally after a variation selector
+func keycapFollows(rest []rune) bool {
+	if len(rest) > 0 && rest[0] == '\ufe0f' {
+		rest = rest[1:]
+	}
+	return len(rest) > 0 && rest[0] == '\u20e3'
+}
+
+func (s *chatService) GetPreviews(ctx context.Context, userID int, msgIDs []int64) (map[int64]MessagePreview, error) {
+	return s.repo.GetMessagePreviews(ctx, userID, msgIDs)
+}
+
+// previewText shortens a quoted message to replyPreviewLen characters,
+// marking the cut with an ellipsis
+func previewText(body string) string {
+	runes := []rune(body)
+	if len(runes) <= replyPreviewLen {
+		return body
+	}
+	return strings.TrimRightFunc(string(runes[:replyPreviewLen]), unicode.IsSpace) + "…"
+}
+
+// validClientMsgID accepts an empty ID (none given) or up to
+// maxClientMsgIDLen printable ASCII characters
+func validClientMsgID(id string) bool {
+	if len(id) > maxClientMsgIDLen {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] <= ' ' || id[i] > '~' {
+			return false
+		}
+	}
+	return true
 }
@@ -3,12 +3,15 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
 	"time"
+
+	"gitea.kood.tech/petrkubec/match-me/backend/events"
 )
 
 // Test saveChatMsg function
@@ -82,6 +85,367 @@ func TestGetChatMessages(t *testing.T) {
 	}
 }
 
+// Only the sender edits and deletes; history and tombstones show in the chat
+func TestEditAndDeleteMessage(t *testing.T) {
+	user1 := createTestUser(t, "editchat1@example.com", "password123")
+	user2 := createTestUser(t, "editchat2@example.com", "password123")
+	testProfile := getDefaultTestProfile()
+	createTestProfile(t, user1, testProfile)
+	createTestProfile(t, user2, testProfile)
+	createConnection(t, user1.ID, user2.ID, "accepted")
+
+	ctx := context.Background()
+	svc := NewChatService(NewChatRepository(db), db)
+	sent, err := svc.SendMessage(ctx, user1.ID, user2.ID, "Helo", 0, "")
+	if err != nil {
+		t.Fatalf("Failed to send message: %v", err)
+	}
+
+	if _, err := svc.EditMessage(ctx, user2.ID, sent.ID, "Hijacked"); !errors.Is(err, ErrNotSender) {
+		t.Errorf("Expected ErrNotSender for the peer, got %v", err)
+	}
+	if _, err := svc.EditMessage(ctx, user1.ID, sent.ID, "  "); !errors.Is(err, ErrEmptyMessage) {
+		t.Errorf("Expected ErrEmptyMessage, got %v", err)
+	}
+
+	edited, err := svc.EditMessage(ctx, user1.ID, sent.ID, "Hello")
+	if err != nil {
+		t.Fatalf("Failed to edit message: %v", err)
+	}
+	if edited.Body != "Hello" || edited.EditedAt == nil || edited.To != user2.ID {
+		t.Errorf("Unexpected edited message %+v", edited)
+	}
+	edits, err := svc.GetEdits(ctx, user2.ID, sent.ID)
+	if err != nil || len(edits) != 1 || edits[0].Body != "Helo" {
+		t.Errorf("Expected the original content in the history, got %+v (%v)", edits, err)
+	}
+
+	// Saving the same content again is not an edit
+	published := 0
+	unsubscribe := events.Default().Subscribe(func(evt events.Event) {
+		if c, ok := evt.Payload.(events.MessageChange); ok && evt.Type == events.MessageEdited && c.ID == sent.ID {
+			published++
+		}
+	})
+	unchanged, err := svc.EditMessage(ctx, user1.ID, sent.ID, "Hello")
+	unsubscribe()
+	if err != nil || unchanged.Body != "Hello" {
+		t.Fatalf("Expected the unchanged message back, got %+v (%v)", unchanged, err)
+	}
+	if published != 0 {
+		t.Errorf("Expected no edit event for unchanged content, got %d", published)
+	}
+	if edits, _ := svc.GetEdits(ctx, user2.ID, sent.ID); len(edits) != 1 {
+		t.Errorf("Expected no new history entry, got %+v", edits)
+	}
+
+	if _, err := svc.DeleteMessage(ctx, user2.ID, sent.ID); !errors.Is(err, ErrNotSender) {
+		t.Errorf("Expected ErrNotSender for the peer, got %v", err)
+	}
+	deleted, err := svc.DeleteMessage(ctx, user1.ID, sent.ID)
+	if err != nil || deleted.DeletedAt == nil || deleted.Body != "" {
+		t.Fatalf("Expected a tombstone, got %+v (%v)", deleted, err)
+	}
+	if _, err := svc.EditMessage(ctx, user1.ID, sent.ID, "Back"); !errors.Is(err, ErrMessageDeleted) {
+		t.Errorf("Expected ErrMessageDeleted, got %v", err)
+	}
+
+	messages, err := getChatMessages(ctx, db, user2.ID, user1.ID, 10, nil)
+	if err != nil || len(messages) != 1 {
+		t.Fatalf("Expected the tombstone in history, got %+v (%v)", messages, err)
+	}
+	if messages[0].Body != "" || messages[0].DeletedAt == nil || messages[0].EditedAt == nil {
+		t.Errorf("Unexpected tombstone %+v", messages[0])
+	}
+	if edits, err := svc.GetEdits(ctx, user1.ID, sent.ID); !errors.Is(err, ErrMessageDeleted) {
+		t.Errorf("Expected ErrMessageDeleted for the history of a deleted message, got %+v (%v)", edits, err)
+	}
+
+	outsider := createTestUser(t, "editchat3@example.com", "password123")
+	if _, err := svc.DeleteMessage(ctx, outsider.ID, sent.ID); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound outside the chat, got %v", err)
+	}
+	if _, err := svc.GetEdits(ctx, outsider.ID, sent.ID); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound for the history outside the chat, got %v", err)
+	}
+}
+
+// Reactions are counted per emoji, once per user, and vanish with the message
+func TestMessageReactions(t *testing.T) {
+	user1 := createTestUser(t, "reactchat1@example.com", "password123")
+	user2 := createTestUser(t, "reactchat2@example.com", "password123")
+	testProfile := getDefaultTestProfile()
+	createTestProfile(t, user1, testProfile)
+	createTestProfile(t, user2, testProfile)
+	createConnection(t, user1.ID, user2.ID, "accepted")
+
+	ctx := context.Background()
+	svc := NewChatService(NewChatRepository(db), db)
+	sent, err := svc.SendMessage(ctx, user1.ID, user2.ID, "Lunch?", 0, "")
+	if err != nil {
+		t.Fatalf("Failed to send message: %v", err)
+	}
+
+	if _, err := svc.AddReaction(ctx, user2.ID, sent.ID, "👍"); err != nil {
+		t.Fatalf("Failed to react: %v", err)
+	}
+	if _, err := svc.AddReaction(ctx, user2.ID, sent.ID, "👍"); err != nil {
+		t.Fatalf("Expected a repeated reaction to be a no-op, got %v", err)
+	}
+	reactions, err := svc.AddReaction(ctx, user1.ID, sent.ID, "👍")
+	if err != nil || len(reactions) != 1 || reactions[0].Count != 2 || !reactions[0].Mine {
+		t.Fatalf("Expected 2 thumbs up including mine, got %+v (%v)", reactions, err)
+	}
+	if _, err := svc.AddReaction(ctx, user1.ID, sent.ID, "ok"); !errors.Is(err, ErrBadReaction) {
+		t.Errorf("Expected ErrBadReaction, got %v", err)
+	}
+
+	reactions, err = svc.RemoveReaction(ctx, user1.ID, sent.ID, "👍")
+	if err != nil || len(reactions) != 1 || reactions[0].Count != 1 || reactions[0].Mine {
+		t.Errorf("Expected 1 thumbs up not mine, got %+v (%v)", reactions, err)
+	}
+
+	messages, err := getChatMessages(ctx, db, user2.ID, user1.ID, 10, nil)
+	if err != nil || len(messages) != 1 || len(messages[0].Reactions) != 1 || !messages[0].Reactions[0].Mine {
+		t.Fatalf("Expected the reaction in history, got %+v (%v)", messages, err)
+	}
+
+	if _, err := svc.DeleteMessage(ctx, user1.ID, sent.ID); err != nil {
+		t.Fatalf("Failed to delete message: %v", err)
+	}
+	if _, err := svc.AddReaction(ctx, user2.ID, sent.ID, "😢"); !errors.Is(err, ErrMessageDeleted) {
+		t.Errorf("Expected ErrMessageDeleted, got %v", err)
+	}
+	messages, _ = getChatMessages(ctx, db, user2.ID, user1.ID, 10, nil)
+	if len(messages) != 1 || len(messages[0].Reactions) != 0 {
+		t.Errorf("Expected no reactions on the tombstone, got %+v", messages)
+	}
+}
+
+func TestValidReaction(t *testing.T) {
+	for _, emoji := range []string{"👍", "❤️", "👍🏽", "👨‍👩‍👧", "🇪🇪", "1️⃣", "#️⃣", "*\u20e3", "🏴\U000e0067\U000e0062\U000e0073\U000e0063\U000e0074\U000e007f"} {
+		if !validReaction(emoji) {
+			t.Errorf("Expected %q to be accepted", emoji)
+		}
+	}
+	for _, emoji := range []string{"", "a", "1", "#\ufe0f", "1\u20e32", "\U000e0067\U000e007f", "👍 ", "+1", "<3", strings.Repeat("👍", maxReactionLen+1)} {
+		if validReaction(emoji) {
+			t.Errorf("Expected %q to be refused", emoji)
+		}
+	}
+}
+
+func TestMessageReplies(t *testing.T) {
+	user1 := createTestUser(t, "replychat1@example.com", "password123")
+	user2 := createTestUser(t, "replychat2@example.com", "password123")
+	user3 := createTestUser(t, "replychat3@example.com", "password123")
+	testProfile := getDefaultTestProfile()
+	createTestProfile(t, user1, testProfile)
+	createTestProfile(t, user2, testProfile)
+	createTestProfile(t, user3, testProfile)
+	createConnection(t, user1.ID, user2.ID, "accepted")
+	createConnection(t, user1.ID, user3.ID, "accepted")
+
+	ctx := context.Background()
+	svc := NewChatService(NewChatRepository(db), db)
+	quoted, err := svc.SendMessage(ctx, user1.ID, user2.ID, "Dinner at eight?", 0, "")
+	if err != nil {
+		t.Fatalf("Failed to send message: %v", err)
+	}
+	other, err := svc.SendMessage(ctx, user1.ID, user3.ID, "Hi", 0, "")
+	if err != nil {
+		t.Fatalf("Failed to send message: %v", err)
+	}
+
+	reply, err := svc.SendMessage(ctx, user2.ID, user1.ID, "Sounds good", quoted.ID, "")
+	if err != nil {
+		t.Fatalf("Failed to reply: %v", err)
+	}
+	if reply.ReplyTo == nil || reply.ReplyTo.ID != quoted.ID || reply.ReplyTo.From != user1.ID || reply.ReplyTo.Body != "Dinner at eight?" {
+		t.Errorf("Expected a preview of the quoted message, got %+v", reply.ReplyTo)
+	}
+	if _, err := svc.SendMessage(ctx, user2.ID, user1.ID, "Wrong chat", other.ID, ""); !errors.Is(err, ErrBadReply) {
+		t.Errorf("Expected ErrBadReply for a message of another chat, got %v", err)
+	}
+
+	if _, err := svc.DeleteMessage(ctx, user1.ID, quoted.ID); err != nil {
+		t.Fatalf("Failed to delete message: %v", err)
+	}
+	if _, err := svc.SendMessage(ctx, user2.ID, user1.ID, "Too late", quoted.ID, ""); !errors.Is(err, ErrMessageDeleted) {
+		t.Errorf("Expected ErrMessageDeleted, got %v", err)
+	}
+	messages, err := getChatMessages(ctx, db, user1.ID, user2.ID, 10, nil)
+	if err != nil || len(messages) != 2 {
+		t.Fatalf("Expected 2 messages, got %+v (%v)", messages, err)
+	}
+	if q := messages[0].ReplyTo; q == nil || q.ID != quoted.ID || !q.Deleted || q.Body != "" {
+		t.Errorf("Expected the quote to become a tombstone, got %+v", q)
+	}
+}
+
+// Acks are cumulative and idempotent; reading sends the sender a receipt too
+func TestMessageReceipts(t *testing.T) {
+	user1 := createTestUser(t, "receiptchat1@example.com", "password123")
+	user2 := createTestUser(t, "receiptchat2@example.com", "password123")
+	testProfile := getDefaultTestProfile()
+	createTestProfile(t, user1, testProfile)
+	createTestProfile(t, user2, testProfile)
+	createConnection(t, user1.ID, user2.ID, "accepted")
+
+	ctx := context.Background()
+	svc := NewChatService(NewChatRepository(db), db)
+	first, err := svc.SendMessage(ctx, user1.ID, user2.ID, "One", 0, "")
+	if err != nil {
+		t.Fatalf("Failed to send message: %v", err)
+	}
+	second, err := svc.SendMessage(ctx, user1.ID, user2.ID, "Two", 0, "")
+	if err != nil {
+		t.Fatalf("Failed to send message: %v", err)
+	}
+
+	receipts := make(chan events.Receipt, 4)
+	unsubscribe := events.Default().Subscribe(func(evt events.Event) {
+		if rc, ok := evt.Payload.(events.Receipt); ok && rc.ChatID == first.ChatID {
+			receipts <- rc
+		}
+	})
+	defer unsubscribe()
+	expect := func(status string, ids ...int64) {
+		t.Helper()
+		select {
+		case rc := <-receipts:
+			if rc.Status != status || rc.SenderID != user1.ID || rc.RecipientID != user2.ID || fmt.Sprint(rc.MessageIDs) != fmt.Sprint(ids) {
+				t.Errorf("Expected a %s receipt for %v, got %+v", status, ids, rc)
+			}
+		default:
+			t.Errorf("Expected a %s receipt", status)
+		}
+	}
+
+	// Acknowledging the latest message covers the earlier ones
+	if err := svc.MarkDelivered(ctx, user2.ID, second.ID); err != nil {
+		t.Fatalf("Failed to acknowledge: %v", err)
+	}
+	expect(receiptDelivered, first.ID, second.ID)
+	if err := svc.MarkDelivered(ctx, user2.ID, second.ID); err != nil || len(receipts) != 0 {
+		t.Errorf("Expected a repeated ack to be a no-op, got %v", err)
+	}
+	if err := svc.MarkDelivered(ctx, user1.ID, second.ID); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound for the sender's own message, got %v", err)
+	}
+
+	if err := svc.MarkRead(ctx, user2.ID, user1.ID); err != nil {
+		t.Fatalf("Failed to mark read: %v", err)
+	}
+	expect(receiptRead, first.ID, second.ID)
+
+	messages, err := getChatMessages(ctx, db, user1.ID, user2.ID, 10, nil)
+	if err != nil || len(messages) != 2 {
+		t.Fatalf("Expected 2 messages, got %+v (%v)", messages, err)
+	}
+	for _, m := range messages {
+		if m.DeliveredAt == nil || m.ReadAt == nil {
+			t.Errorf("Expected message %d delivered and read, got %+v", m.ID, m)
+		}
+	}
+}
+
+// A resent client_msg_id returns the stored message without sending it again
+func TestClientMessageIDs(t *testing.T) {
+	user1 := createTestUser(t, "clientidchat1@example.com", "password123")
+	user2 := createTestUser(t, "clientidchat2@example.com", "password123")
+	testProfile := getDefaultTestProfile()
+	createTestProfile(t, user1, testProfile)
+	createTestProfile(t, user2, testProfile)
+	createConnection(t, user1.ID, user2.ID, "accepted")
+
+	ctx := context.Background()
+	svc := NewChatService(NewChatRepository(db), db)
+	published := 0
+	unsubscribe := events.Default().Subscribe(func(evt events.Event) {
+		if m, ok := evt.Payload.(events.Message); ok && m.SenderID == user1.ID {
+			published++
+		}
+	})
+	defer unsubscribe()
+
+	sent, err := svc.SendMessage(ctx, user1.ID, user2.ID, "Hello", 0, "pending-1")
+	if err != nil {
+		t.Fatalf("Failed to send message: %v", err)
+	}
+	retried, err := svc.SendMessage(ctx, user1.ID, user2.ID, "Hello", 0, "pending-1")
+	if err != nil {
+		t.Fatalf("Failed to resend message: %v", err)
+	}
+	if retried.ID != sent.ID || retried.ClientMsgID != "pending-1" || !retried.Ts.Equal(sent.Ts) {
+		t.Errorf("Expected the stored message back, got %+v for %+v", retried, sent)
+	}
+	if published != 1 {
+		t.Errorf("Expected the message delivered once, got %d", published)
+	}
+	if other, err := svc.SendMessage(ctx, user2.ID, user1.ID, "Hi", 0, "pending-1"); err != nil || other.ID == sent.ID {
+		t.Errorf("Expected client IDs to be scoped to the sender, got %+v (%v)", other, err)
+	}
+
+	if _, err := svc.SendMessage(ctx, user1.ID, user2.ID, "Hello", 0, "has space"); !errors.Is(err, ErrBadClientMsgID) {
+		t.Errorf("Expected ErrBadClientMsgID, got %v", err)
+	}
+	if _, err := svc.SendMessage(ctx, user1.ID, user2.ID, " ", 0, "pending-2"); !errors.Is(err, ErrEmptyMessage) {
+		t.Errorf("Expected ErrEmptyMessage, got %v", err)
+	}
+
+	// Reusing an ID in another chat is a conflict, not a retry
+	user3 := createTestUser(t, "clientidchat3@example.com", "password123")
+	createTestProfile(t, user3, testProfile)
+	createConnection(t, user1.ID, user3.ID, "accepted")
+	if _, err := svc.SendMessage(ctx, user1.ID, user3.ID, "Hello", 0, "pending-1"); !errors.Is(err, ErrBadClientMsgID) {
+		t.Errorf("Expected ErrBadClientMsgID for an ID used in another chat, got %v", err)
+	}
+	if published != 1 {
+		t.Errorf("Expected nothing delivered for the conflicting send, got %d deliveries", published)
+	}
+
+	messages, err := getChatMessages(ctx, db, user1.ID, user2.ID, 10, nil)
+	if err != nil || len(messages) != 2 {
+		t.Errorf("Expected 2 messages without duplicates, got %+v (%v)", messages, err)
+	}
+	if messages, err := getChatMessages(ctx, db, user1.ID, user3.ID, 10, nil); err != nil || len(messages) != 0 {
+		t.Errorf("Expected no messages in the other chat, got %+v (%v)", messages, err)
+	}
+}
+
+func TestSendFailure(t *testing.T) {
+	cases := []struct {
+		err  error
+		code string
+	}{
+		{ErrBlocked, "no_connection"},
+		{ErrNoConnection, "no_connection"},
+		{ErrBadReply, "invalid_reply"},
+		{ErrBadClientMsgID, "invalid_client_msg_id"},
+		{errors.New("connection reset"), "send_failed"},
+	}
+	for _, c := range cases {
+		if f := sendFailure("pending-1", c.err); f.Code != c.code || f.ClientMsgID != "pending-1" || f.Error == "" {
+			t.Errorf("%v: expected code %s, got %+v", c.err, c.code, f)
+		}
+	}
+	f := sendFailure("", &RateLimitError{Code: codeRateLimited, Wait: 1500 * time.Millisecond})
+	if f.Code != codeRateLimited || f.RetryAfter != 2 {
+		t.Errorf("Expected rate_limited with a 2s retry, got %+v", f)
+	}
+}
+
+func TestPreviewText(t *testing.T) {
+	if got := previewText("short"); got != "short" {
+		t.Errorf("Expected short text unchanged, got %q", got)
+	}
+	long := strings.Repeat("ä", replyPreviewLen-1) + " and more"
+	if got := previewText(long); got != strings.Repeat("ä", replyPreviewLen-1)+"…" {
+		t.Errorf("Expected the text cut after %d characters, got %q", replyPreviewLen, got)
+	}
+}
+
 // Test Hub functionality
 func TestHubBasic(t *testing.T) {
 	hub := newHub()
@@ -120,6 +484,117 @@ func TestHubBasic(t *testing.T) {
 	})
 }
 
+// Messages published on the event bus reach both participants
+func TestHubHandleEvent(t *testing.T) {
+	hub := newHub()
+	sender := &Client{userID: 10, send: make(chan ServerEvent, 4)}
+	recipient := &Client{userID: 20, send: make(chan ServerEvent, 4)}
+	hub.register(sender)
+	hub.register(recipient)
+
+	hub.handleEvent(events.Event{
+		Type: events.MessageCreated,
+		Payload: events.Message{
+			ID: 1, ChatID: 5, SenderID: 10, RecipientID: 20, Body: "hello", CreatedAt: time.Now(),
+		},
+	})
+
+	for _, c := range []*Client{sender, recipient} {
+		select {
+		case evt := <-c.send:
+			msg, ok := evt.Data.(ChatMessage)
+			if evt.Type != "message" || !ok || msg.Body != "hello" || msg.ChatID != 5 {
+				t.Errorf("user %d: unexpected event %#v", c.userID, evt)
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Errorf("user %d did not receive the message", c.userID)
+		}
+	}
+}
+
+// Edits and deletions update the message in place on both sides
+func TestHubHandleMessageChange(t *testing.T) {
+	hub := newHub()
+	sender := &Client{userID: 11, send: make(chan ServerEvent, 4)}
+	recipient := &Client{userID: 21, send: make(chan ServerEvent, 4)}
+	hub.register(sender)
+	hub.register(recipient)
+
+	hub.handleEvent(events.Event{
+		Type:    events.MessageDeleted,
+		Payload: events.MessageChange{ID: 1, ChatID: 5, SenderID: 11, RecipientID: 21, At: time.Now()},
+	})
+
+	for _, c := range []*Client{sender, recipient} {
+		select {
+		case evt := <-c.send:
+			msg, ok := evt.Data.(ChatMessage)
+			if evt.Type != "delete" || !ok || msg.ID != 1 || msg.DeletedAt == nil || msg.Body != "" {
+				t.Errorf("user %d: unexpected event %#v", c.userID, evt)
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Errorf("user %d did not receive the deletion", c.userID)
+		}
+	}
+}
+
+func TestHubHandleReceipt(t *testing.T) {
+	hub := newHub()
+	sender := &Client{userID: 12, send: make(chan ServerEvent, 4)}
+	recipient := &Client{userID: 22, send: make(chan ServerEvent, 4)}
+	hub.register(sender)
+	hub.register(recipient)
+
+	hub.handleEvent(events.Event{
+		Type:    events.MessageStatus,
+		Payload: events.Receipt{ChatID: 5, SenderID: 12, RecipientID: 22, MessageIDs: []int64{3, 4}, Status: "read", At: time.Now()},
+	})
+
+	select {
+	case evt := <-sender.send:
+		rc, ok := evt.Data.(Receipt)
+		if evt.Type != "read" || evt.From != 22 || !ok || rc.ChatID != 5 || len(rc.MessageIDs) != 2 {
+			t.Errorf("unexpected event %#v", evt)
+		}
+	case <-time.After(100 * time.Millisecond):
+		t.Error("sender did not receive the read receipt")
+	}
+	select {
+	case evt := <-recipient.send:
+		t.Errorf("Expected nothing for the reader, got %#v", evt)
+	default:
+	}
+}
+
+func TestHubHandlePresenceEvent(t *testing.T) {
+	hub := newHub()
+	friend := &Client{userID: 30, send: make(chan ServerEvent, 4)}
+	stranger := &Client{userID: 40, send: make(chan ServerEvent, 4)}
+	hub.register(friend)
+	hub.register(stranger)
+
+	hub.handleEvent(events.Event{
+		Type:    events.PresenceChanged,
+		Payload: events.Presence{UserID: 50, Online: true, LastOnline: time.Now(), Audience: []int{30}},
+	})
+
+	select {
+	case evt := <-friend.send:
+		p, ok := evt.Data.(PresenceEvent)
+		if evt.Type != "presence" || !ok || p.UserID != 50 || !p.IsOnline {
+			t.Errorf("unexpected event %#v", evt)
+		}
+	case <-time.After(100 * time.Millisecond):
+		t.Error("connection did not receive the presence event")
+	}
+
+	select {
+	case evt := <-stranger.send:
+		t.Errorf("user outside the audience received %#v", evt)
+	default:
+	}
+}
+
 // Test getChatHistoryHandler
 func TestGetChatHistoryHandler(t *testing.T) {
 	// Create test users and data
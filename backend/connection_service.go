@@ -4,6 +4,8 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+
+	"gitea.kood.tech/petrkubec/match-me/backend/events"
 )
 
 var (
@@ -62,6 +64,14 @@ func (s *connectionService) RequestConnection(ctx context.Context, me, targetID
 	var connID *int
 
 	err = withTx(ctx, s.db, func(tx *sql.Tx) error {
+		blocked, err := isBlocked(ctx, tx, me, targetID)
+		if err != nil {
+			return err
+		}
+		if blocked {
+			// a blocked pair looks like a missing user from both sides
+			return ErrNotFound
+		}
 		row, err := s.repo.LoadPairForUpdate(tx, me, targetID)
 		if err != nil {
 			return err
@@ -105,6 +115,9 @@ func (s *connectionService) RequestConnection(ctx context.Context, me, targetID
 		return nil
 	})
 
+	if err == nil {
+		publishConnection(me, targetID, state)
+	}
 	return state, connID, err
 }
 
@@ -122,6 +135,14 @@ func (s *connectionService) AcceptConnection(ctx context.Context, me, targetID i
 	var connID *int
 
 	err = withTx(ctx, s.db, func(tx *sql.Tx) error {
+		blocked, err := isBlocked(ctx, tx, me, targetID)
+		if err != nil {
+			return err
+		}
+		if blocked {
+			// a blocked pair looks like a missing user from both sides
+			return ErrNotFound
+		}
 		row, err := s.repo.LoadPairForUpdate(tx, me, targetID)
 		if err != nil {
 			return err
@@ -152,6 +173,9 @@ func (s *connectionService) AcceptConnection(ctx context.Context, me, targetID i
 		}
 	})
 
+	if err == nil {
+		publishConnection(me, targetID, state)
+	}
 	return state, connID, err
 }
 
@@ -197,6 +221,9 @@ func (s *connectionService) DeclineConnection(ctx context.Context, me, targetID
 		}
 	})
 
+	if err == nil {
+		publishConnection(me, targetID, state)
+	}
 	return state, err
 }
 
@@ -242,6 +269,9 @@ func (s *connectionService) CancelConnection(ctx context.Context, me, targetID i
 		}
 	})
 
+	if err == nil {
+		publishConnection(me, targetID, state)
+	}
 	return state, err
 }
 
@@ -284,5 +314,14 @@ func (s *connectionService) DisconnectConnection(ctx context.Context, me, target
 		}
 	})
 
+	if err == nil {
+		publishConnection(me, targetID, "disconnected")
+	}
 	return okNoContent, err
 }
+
+// publishConnection announces a settled connection action so derived state
+// (the recommendation lists of both users) can be refreshed
+func publishConnection(me, targetID int, state string) {
+	events.Default().PublishConnection(events.Connection{UserID: me, TargetID: targetID, Status: state})
+}
@@ -29,7 +29,6 @@ func initDB() {
 	}
 	log.Default().Println("Database connection established successfully")
 
-	// PAUNO's comment 8.8.25:
-	// For now, the database structure needs to be created manually using postgre on command line.
-	// Later on, maybe here a fallback function, which initializes the db in case it doesn't exist.
+	// The schema itself is managed by the embedded migrations (see migrate.go),
+	// applied by main() at startup or by hand with `backend migrate`.
 }
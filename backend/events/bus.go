@@ -0,0 +1,278 @@
+// Package events provides the in-process event bus shared by the REST/WebSocket
+// layer and the GraphQL layer. Producers publish domain events once; every
+// transport (WebSocket Hub, GraphQL SubscriptionManager, ...) subscribes and
+// converts the event into its own wire format.
+package events
+
+import (
+	"sync"
+	"time"
+)
+
+// Event types
+const (
+	MessageCreated  = "message.created"
+	MessageEdited   = "message.edited"
+	MessageDeleted  = "message.deleted"
+	ReactionChanged = "reaction.changed"
+	MessageStatus   = "message.status"
+	PresenceChanged = "presence.changed"
+	TypingChanged   = "typing.changed"
+
+	ProfileChanged          = "profile.changed"
+	ConnectionChanged       = "connection.changed"
+	RecommendationDismissed = "recommendation.dismissed"
+	BlockChanged            = "block.changed"
+	AccountChanged          = "account.changed"
+
+	SecurityAudit = "security.audit"
+)
+
+// Event is a single domain event travelling through the bus
+type Event struct {
+	Type    string
+	Payload any
+}
+
+// Message is the payload of a MessageCreated event. ReplyTo is set when the
+// message quotes an earlier one; ClientMsgID is the sender client's own ID
+// for it, if it gave one.
+type Message struct {
+	ID          int64
+	ChatID      int
+	SenderID    int
+	RecipientID int
+	Body        string
+	CreatedAt   time.Time
+	ReplyTo     *Quote
+	ClientMsgID string
+}
+
+// Quote is a compact preview of a quoted message. Body is shortened and empty
+// once the quoted message was deleted.
+type Quote struct {
+	ID       int64
+	SenderID int
+	Body     string
+	Deleted  bool
+}
+
+// MessageChange is the payload of MessageEdited and MessageDeleted events.
+// Body is the new content, empty for deletions; At is when it happened.
+type MessageChange struct {
+	ID          int64
+	ChatID      int
+	SenderID    int
+	RecipientID int
+	Body        string
+	CreatedAt   time.Time
+	At          time.Time
+}
+
+// Reaction is the payload of a ReactionChanged event: UserID added or removed
+// the emoji on a message in their chat with PeerID. Count is how many users
+// now reacted with it.
+type Reaction struct {
+	MessageID int64
+	ChatID    int
+	UserID    int
+	PeerID    int
+	Emoji     string
+	Added     bool
+	Count     int
+}
+
+// Receipt is the payload of a MessageStatus event: RecipientID's client
+// received ("delivered") or RecipientID read ("read") these messages from
+// SenderID at At.
+type Receipt struct {
+	ChatID      int
+	SenderID    int
+	RecipientID int
+	MessageIDs  []int64
+	Status      string
+	At          time.Time
+}
+
+// Presence is the payload of a PresenceChanged event. Audience lists the users
+// that should be told about the transition (the user's accepted connections).
+type Presence struct {
+	UserID     int
+	Online     bool
+	LastOnline time.Time
+	Audience   []int
+}
+
+// Typing is the payload of a TypingChanged event. ChatID is 0 when the pair
+// has not exchanged any message yet.
+type Typing struct {
+	ChatID int
+	UserID int
+	PeerID int
+	Typing bool
+}
+
+// Profile is the payload of a ProfileChanged event (matching-relevant fields
+// of the user's profile or bio were written)
+type Profile struct {
+	UserID int
+}
+
+// Connection is the payload of a ConnectionChanged event. Status is the new
+// state of the pair, "" when the connection row was removed.
+type Connection struct {
+	UserID   int
+	TargetID int
+	Status   string
+}
+
+// Dismissal is the payload of a RecommendationDismissed event
+type Dismissal struct {
+	UserID          int
+	DismissedUserID int
+}
+
+// Block is the payload of a BlockChanged event. Blocked is false when the
+// block was lifted.
+type Block struct {
+	UserID    int
+	BlockedID int
+	Blocked   bool
+}
+
+// Account is the payload of an AccountChanged event: a moderator banned or
+// suspended the user ("banned", "suspended"), or lifted that ("active").
+type Account struct {
+	UserID int
+	Status string
+}
+
+// Audit is the payload of a SecurityAudit event: a request was turned away
+// by a rate limit ("rate_limited") or an account was locked after repeated
+// failed logins ("account_locked"). Key names what was limited, e.g.
+// "login:203.0.113.7"; Until is when it is allowed again.
+type Audit struct {
+	Action string
+	Key    string
+	Until  time.Time
+}
+
+// Handler receives published events. Handlers run synchronously on the
+// publisher's goroutine, so they must not block (both transports use
+// non-blocking, buffered channel sends).
+type Handler func(Event)
+
+// Bus is a minimal fan-out publish/subscribe bus
+type Bus struct {
+	mu       sync.RWMutex
+	nextID   int
+	handlers map[int]Handler
+}
+
+// NewBus creates an empty bus
+func NewBus() *Bus {
+	return &Bus{handlers: make(map[int]Handler)}
+}
+
+// Global bus instance
+var defaultBus = NewBus()
+
+// Default returns the process-wide bus
+func Default() *Bus {
+	return defaultBus
+}
+
+// Subscribe registers a handler and returns a function that removes it
+func (b *Bus) Subscribe(h Handler) func() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	id := b.nextID
+	b.nextID++
+	b.handlers[id] = h
+
+	return func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		delete(b.handlers, id)
+	}
+}
+
+// Publish delivers the event to every subscriber
+func (b *Bus) Publish(evt Event) {
+	b.mu.RLock()
+	handlers := make([]Handler, 0, len(b.handlers))
+	for _, h := range b.handlers {
+		handlers = append(handlers, h)
+	}
+	b.mu.RUnlock()
+
+	for _, h := range handlers {
+		h(evt)
+	}
+}
+
+// PublishMessage is a convenience wrapper for MessageCreated events
+func (b *Bus) PublishMessage(msg Message) {
+	b.Publish(Event{Type: MessageCreated, Payload: msg})
+}
+
+// PublishMessageEdited is a convenience wrapper for MessageEdited events
+func (b *Bus) PublishMessageEdited(c MessageChange) {
+	b.Publish(Event{Type: MessageEdited, Payload: c})
+}
+
+// PublishMessageDeleted is a convenience wrapper for MessageDeleted events
+func (b *Bus) PublishMessageDeleted(c MessageChange) {
+	b.Publish(Event{Type: MessageDeleted, Payload: c})
+}
+
+// PublishReaction is a convenience wrapper for ReactionChanged events
+func (b *Bus) PublishReaction(r Reaction) {
+	b.Publish(Event{Type: ReactionChanged, Payload: r})
+}
+
+// PublishReceipt is a convenience wrapper for MessageStatus events
+func (b *Bus) PublishReceipt(r Receipt) {
+	b.Publish(Event{Type: MessageStatus, Payload: r})
+}
+
+// PublishPresence is a convenience wrapper for PresenceChanged events
+func (b *Bus) PublishPresence(p Presence) {
+	b.Publish(Event{Type: PresenceChanged, Payload: p})
+}
+
+// PublishTyping is a convenience wrapper for TypingChanged events
+func (b *Bus) PublishTyping(t Typing) {
+	b.Publish(Event{Type: TypingChanged, Payload: t})
+}
+
+// PublishProfile is a convenience wrapper for ProfileChanged events
+func (b *Bus) PublishProfile(p Profile) {
+	b.Publish(Event{Type: ProfileChanged, Payload: p})
+}
+
+// PublishConnection is a convenience wrapper for ConnectionChanged events
+func (b *Bus) PublishConnection(c Connection) {
+	b.Publish(Event{Type: ConnectionChanged, Payload: c})
+}
+
+// PublishDismissal is a convenience wrapper for RecommendationDismissed events
+func (b *Bus) PublishDismissal(d Dismissal) {
+	b.Publish(Event{Type: RecommendationDismissed, Payload: d})
+}
+
+// PublishBlock is a convenience wrapper for BlockChanged events
+func (b *Bus) PublishBlock(bl Block) {
+	b.Publish(Event{Type: BlockChanged, Payload: bl})
+}
+
+// PublishAccount is a convenience wrapper for AccountChanged events
+func (b *Bus) PublishAccount(a Account) {
+	b.Publish(Event{Type: AccountChanged, Payload: a})
+}
+
+// PublishAudit is a convenience wrapper for SecurityAudit events
+func (b *Bus) PublishAudit(a Audit) {
+	b.Publish(Event{Type: SecurityAudit, Payload: a})
+}
@@ -0,0 +1,38 @@
+package events
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBusPublishSubscribe(t *testing.T) {
+	bus := NewBus()
+
+	var gotA, gotB []Event
+	unsubA := bus.Subscribe(func(e Event) { gotA = append(gotA, e) })
+	bus.Subscribe(func(e Event) { gotB = append(gotB, e) })
+
+	msg := Message{ID: 1, ChatID: 2, SenderID: 3, RecipientID: 4, Body: "hi", CreatedAt: time.Now()}
+	bus.PublishMessage(msg)
+
+	if len(gotA) != 1 || len(gotB) != 1 {
+		t.Fatalf("expected both subscribers to receive 1 event, got %d and %d", len(gotA), len(gotB))
+	}
+	if gotA[0].Type != MessageCreated {
+		t.Errorf("expected type %s, got %s", MessageCreated, gotA[0].Type)
+	}
+	if p, ok := gotA[0].Payload.(Message); !ok || p.Body != "hi" {
+		t.Errorf("unexpected payload: %#v", gotA[0].Payload)
+	}
+
+	t.Run("Unsubscribe", func(t *testing.T) {
+		unsubA()
+		bus.Publish(Event{Type: "other"})
+		if len(gotA) != 1 {
+			t.Errorf("expected unsubscribed handler to receive nothing, got %d events", len(gotA))
+		}
+		if len(gotB) != 2 {
+			t.Errorf("expected remaining handler to receive 2 events, got %d", len(gotB))
+		}
+	})
+}
@@ -0,0 +1,306 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"gitea.kood.tech/petrkubec/match-me/backend/graph/model"
+)
+
+// The gazetteer turns city names into coordinates without any network call.
+// A compact list of cities ships with the binary; GAZETTEER_FILE can point at
+// a larger list in the same format, or at a GeoNames dump such as
+// cities15000.txt, which is recognised by its column count.
+//
+//go:embed config/cities.tsv
+var defaultCitiesTSV []byte
+
+const (
+	defaultCitySearchLimit = 10
+	maxCitySearchLimit     = 50
+
+	// cityMatchRadiusKm is how far client-sent coordinates may lie from the
+	// city they name before the pair is rejected as inconsistent
+	cityMatchRadiusKm = 50
+)
+
+var (
+	ErrUnknownCity      = errors.New("unknown_city")
+	ErrLocationMismatch = errors.New("location_mismatch")
+)
+
+// City is one gazetteer entry
+type City struct {
+	Name       string  `json:"name"`
+	Country    string  `json:"country"`
+	Lat        float64 `json:"lat"`
+	Lon        float64 `json:"lon"`
+	Population int     `json:"population"`
+
+	keys []string // folded name, ASCII name and alternate names
+}
+
+type Gazetteer struct {
+	cities []City // sorted by population, largest first
+}
+
+// parseGazetteer reads the compact format (name, asciiname, alternatenames,
+// country_code, latitude, longitude, population) or a 19-column GeoNames dump.
+// Lines starting with # are comments.
+func parseGazetteer(r io.Reader) (*Gazetteer, error) {
+	g := &Gazetteer{}
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	line := 0
+	for sc.Scan() {
+		line++
+		text := sc.Text()
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		f := strings.Split(text, "\t")
+
+		var name, ascii, alternates, country, lat, lon, pop string
+		switch len(f) {
+		case 7:
+			name, ascii, alternates, country, lat, lon, pop = f[0], f[1], f[2], f[3], f[4], f[5], f[6]
+		case 19: // GeoNames
+			name, ascii, alternates, country, lat, lon, pop = f[1], f[2], f[3], f[8], f[4], f[5], f[14]
+		default:
+			return nil, fmt.Errorf("gazetteer line %d: expected 7 or 19 columns, got %d", line, len(f))
+		}
+
+		c := City{Name: name, Country: country}
+		var err error
+		if c.Lat, err = strconv.ParseFloat(lat, 64); err != nil || c.Lat < -90 || c.Lat > 90 {
+			return nil, fmt.Errorf("gazetteer line %d: invalid latitude %q", line, lat)
+		}
+		if c.Lon, err = strconv.ParseFloat(lon, 64); err != nil || c.Lon < -180 || c.Lon > 180 {
+			return nil, fmt.Errorf("gazetteer line %d: invalid longitude %q", line, lon)
+		}
+		c.Population, _ = strconv.Atoi(pop)
+
+		for _, k := range append([]string{name, ascii}, strings.Split(alternates, ",")...) {
+			if k = foldCityName(k); k != "" {
+				c.keys = addUnique(c.keys, k)
+			}
+		}
+		g.cities = append(g.cities, c)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	sort.SliceStable(g.cities, func(i, j int) bool { return g.cities[i].Population > g.cities[j].Population })
+	return g, nil
+}
+
+var (
+	gazetteerOnce sync.Once
+	gazetteerData *Gazetteer
+)
+
+// gazetteer returns the process-wide gazetteer, loading it on first use
+func gazetteer() *Gazetteer {
+	gazetteerOnce.Do(func() {
+		if path := os.Getenv("GAZETTEER_FILE"); path != "" {
+			f, err := os.Open(path)
+			if err == nil {
+				gazetteerData, err = parseGazetteer(f)
+				f.Close()
+			}
+			if err == nil {
+				return
+			}
+			log.Printf("gazetteer: cannot load %s, using the built-in list: %v", path, err)
+		}
+		g, err := parseGazetteer(bytes.NewReader(defaultCitiesTSV))
+		if err != nil {
+			panic(err)
+		}
+		gazetteerData = g
+	})
+	return gazetteerData
+}
+
+var cityFolder = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ä", "a", "ã", "a", "å", "a", "ā", "a", "ą", "a", "æ", "ae",
+	"ç", "c", "č", "c", "ć", "c",
+	"é", "e", "è", "e", "ê", "e", "ë", "e", "ē", "e", "ė", "e", "ę", "e",
+	"í", "i", "ì", "i", "î", "i", "ï", "i", "ī", "i", "į", "i",
+	"ł", "l", "ļ", "l", "ñ", "n", "ń", "n", "ņ", "n",
+	"ó", "o", "ò", "o", "ô", "o", "ö", "o", "õ", "o", "ø", "o", "ő", "o",
+	"ú", "u", "ù", "u", "û", "u", "ü", "u", "ū", "u", "ų", "u", "ű", "u",
+	"ý", "y", "ÿ", "y", "ž", "z", "ź", "z", "ż", "z", "š", "s", "ś", "s", "ș", "s", "ş", "s",
+	"ț", "t", "ţ", "t", "ğ", "g", "ģ", "g", "ķ", "k", "ř", "r", "ď", "d", "ß", "ss",
+	"-", " ", "'", "", "’", "", ".", "",
+)
+
+// foldCityName normalises a name for matching: lower case, no diacritics,
+// hyphens as spaces and single spaces
+func foldCityName(s string) string {
+	return strings.Join(strings.Fields(cityFolder.Replace(strings.ToLower(s))), " ")
+}
+
+// splitCityQuery separates an optional ", CC" country suffix from the name
+func splitCityQuery(q string) (string, string) {
+	if name, country, ok := strings.Cut(q, ","); ok {
+		if country = strings.TrimSpace(country); len(country) == 2 {
+			return name, strings.ToUpper(country)
+		}
+	}
+	return q, ""
+}
+
+// matches lists the cities whose name, ASCII name or alternate name equals
+// query, most populous first. "Name, CC" restricts them to one country.
+func (g *Gazetteer) matches(query string) []City {
+	name, country := splitCityQuery(query)
+	key := foldCityName(name)
+	if key == "" {
+		return nil
+	}
+	var out []City
+	for _, c := range g.cities {
+		if country != "" && c.Country != country {
+			continue
+		}
+		for _, k := range c.keys {
+			if k == key {
+				out = append(out, c)
+				break
+			}
+		}
+	}
+	return out
+}
+
+// Lookup finds the most populous city named query
+func (g *Gazetteer) Lookup(query string) (City, bool) {
+	if m := g.matches(query); len(m) > 0 {
+		return m[0], true
+	}
+	return City{}, false
+}
+
+// Search returns up to limit cities with a name starting with query. Exact
+// names come first, then the rest by population.
+func (g *Gazetteer) Search(query string, limit int) []City {
+	name, country := splitCityQuery(query)
+	key := foldCityName(name)
+	if key == "" || limit <= 0 {
+		return nil
+	}
+	var exact, prefix []City
+	for _, c := range g.cities {
+		if country != "" && c.Country != country {
+			continue
+		}
+		match := 0
+		for _, k := range c.keys {
+			if k == key {
+				match = 2
+				break
+			}
+			if strings.HasPrefix(k, key) {
+				match = 1
+			}
+		}
+		switch match {
+		case 2:
+			exact = append(exact, c)
+		case 1:
+			prefix = append(prefix, c)
+		}
+	}
+	out := append(exact, prefix...)
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out
+}
+
+// ResolveLocation makes a profile's city and coordinates agree. Without
+// coordinates (hasCoords false) a known city supplies them. With coordinates,
+// one of the cities of that name must lie within cityMatchRadiusKm. Known
+// cities are returned under their gazetteer name; unknown ones are only
+// accepted together with coordinates.
+func (g *Gazetteer) ResolveLocation(city string, lat, lon float64, hasCoords bool) (string, float64, float64, error) {
+	matches := g.matches(city)
+	switch {
+	case len(matches) == 0 && !hasCoords:
+		return "", 0, 0, ErrUnknownCity
+	case len(matches) == 0:
+		return strings.TrimSpace(city), lat, lon, nil
+	case !hasCoords:
+		return matches[0].Name, matches[0].Lat, matches[0].Lon, nil
+	}
+	for _, c := range matches {
+		if haversine(lat, lon, c.Lat, c.Lon) <= cityMatchRadiusKm {
+			return c.Name, lat, lon, nil
+		}
+	}
+	return "", 0, 0, ErrLocationMismatch
+}
+
+// GET /geo/cities?q=&limit= - City autocomplete from the offline gazetteer
+func citySearchHandler() http.HandlerFunc {
+	return authenticate(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			writeError(w, http.StatusMethodNotAllowed, "invalid_method")
+			return
+		}
+		q := strings.TrimSpace(r.URL.Query().Get("q"))
+		if q == "" {
+			writeError(w, http.StatusBadRequest, "missing_query")
+			return
+		}
+		limit := defaultCitySearchLimit
+		if v := r.URL.Query().Get("limit"); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil || n < 1 {
+				writeError(w, http.StatusBadRequest, "invalid_limit")
+				return
+			}
+			limit = min(n, maxCitySearchLimit)
+		}
+
+		cities := gazetteer().Search(q, limit)
+		if cities == nil {
+			cities = []City{}
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"cities": cities})
+	})
+}
+
+// graphGeoService exposes the gazetteer to the GraphQL layer
+type graphGeoService struct{}
+
+func (graphGeoService) ResolveLocation(city string, lat, lon *float64) (string, float64, float64, error) {
+	if lat != nil && lon != nil {
+		return gazetteer().ResolveLocation(city, *lat, *lon, true)
+	}
+	return gazetteer().ResolveLocation(city, 0, 0, false)
+}
+
+func (graphGeoService) DisplayLocation(userID int, lat, lon float64) (float64, float64) {
+	return displayLocation(userID, lat, lon)
+}
+
+func (graphGeoService) SearchCities(query string, limit int) []*model.City {
+	out := []*model.City{}
+	for _, c := range gazetteer().Search(query, limit) {
+		out = append(out, &model.City{Name: c.Name, Country: c.Country, Lat: c.Lat, Lon: c.Lon, Population: c.Population})
+	}
+	return out
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestGazetteerLookup(t *testing.T) {
+	g := gazetteer()
+
+	cases := map[string]string{
+		"Helsinki":        "Helsinki",
+		"helsingfors":     "Helsinki",
+		"JYVASKYLA":       "Jyväskylä",
+		"  tallinn ":      "Tallinn",
+		"Sankt-Peterburg": "Saint Petersburg",
+		"Frankfurt, DE":   "Frankfurt am Main",
+		"nuku'alofa":      "Nuku'alofa",
+		"kohtla jarve":    "Kohtla-Järve",
+	}
+	for q, want := range cases {
+		c, ok := g.Lookup(q)
+		if !ok || c.Name != want {
+			t.Errorf("Lookup(%q) = %q, %v; want %q", q, c.Name, ok, want)
+		}
+	}
+	if _, ok := g.Lookup("Helsinki, SE"); ok {
+		t.Error("country suffix should restrict the match")
+	}
+	if _, ok := g.Lookup("Atlantis"); ok {
+		t.Error("unexpected match for an unknown city")
+	}
+}
+
+func TestGazetteerSearch(t *testing.T) {
+	g := gazetteer()
+
+	got := g.Search("ta", 3)
+	if len(got) != 3 || got[0].Name != "Tallinn" || got[1].Name != "Tampere" {
+		t.Fatalf("expected the most populous prefix matches first, got %+v", got)
+	}
+	// an exact name beats larger prefix matches
+	got = g.Search("riga", 5)
+	if len(got) == 0 || got[0].Name != "Riga" {
+		t.Fatalf("expected Riga first, got %+v", got)
+	}
+	if got := g.Search("", 5); got != nil {
+		t.Fatalf("expected no results for an empty query, got %+v", got)
+	}
+}
+
+func TestParseGazetteerGeoNames(t *testing.T) {
+	// a cities15000.txt line, 19 tab-separated columns
+	line := strings.Join([]string{"658225", "Helsinki", "Helsinki", "Helsingfors,Helsinki", "60.16952", "24.93545",
+		"P", "PPLC", "FI", "", "01", "091", "", "", "558457", "", "26", "Europe/Helsinki", "2019-11-25"}, "\t")
+	g, err := parseGazetteer(strings.NewReader(line + "\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, ok := g.Lookup("helsingfors")
+	if !ok || c.Country != "FI" || c.Population != 558457 || c.Lat != 60.16952 {
+		t.Fatalf("unexpected entry %+v", c)
+	}
+
+	if _, err := parseGazetteer(strings.NewReader("Helsinki\tFI\n")); err == nil {
+		t.Fatal("expected a malformed line to be rejected")
+	}
+}
+
+func TestResolveLocation(t *testing.T) {
+	g := gazetteer()
+
+	city, lat, lon, err := g.ResolveLocation("turku", 0, 0, false)
+	if err != nil || city != "Turku" || lat != 60.4518 || lon != 22.2666 {
+		t.Fatalf("expected Turku to be geocoded, got %q %v %v %v", city, lat, lon, err)
+	}
+
+	// coordinates within the city are kept
+	if _, lat, _, err := g.ResolveLocation("Turku", 60.46, 22.30, true); err != nil || lat != 60.46 {
+		t.Fatalf("expected the client coordinates to be kept, got %v %v", lat, err)
+	}
+	if _, _, _, err := g.ResolveLocation("Turku", 60.17, 24.94, true); !errors.Is(err, ErrLocationMismatch) {
+		t.Fatalf("expected Helsinki coordinates to mismatch Turku, got %v", err)
+	}
+	if _, _, _, err := g.ResolveLocation("Atlantis", 0, 0, false); !errors.Is(err, ErrUnknownCity) {
+		t.Fatalf("expected unknown city, got %v", err)
+	}
+	if city, _, _, err := g.ResolveLocation("Atlantis ", 10, 10, true); err != nil || city != "Atlantis" {
+		t.Fatalf("expected an unknown city with coordinates to be accepted, got %q %v", city, err)
+	}
+}
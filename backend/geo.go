@@ -0,0 +1,93 @@
+package main
+
+import "math"
+
+// Profiles are indexed on a fixed grid of 1°×1° cells. profiles.geo_cell is a
+// generated column (see migrations/0007_profile_geo_cells) computing
+//
+//	row*geoGridCols + col, row = floor(lat + 90) capped at 179, col = floor(lon + 180) mod 360
+//
+// so it is kept up to date by every write path. geoCell below must stay in
+// sync with that expression.
+const (
+	geoGridRows = 180
+	geoGridCols = 360
+
+	// earthRadiusKm matches haversine and the SQL distance filter
+	earthRadiusKm = 6371.0
+
+	// geoMaxCells caps the cell list sent to the database. Searches covering
+	// more cells fall back to a latitude band.
+	geoMaxCells = 2048
+)
+
+// geoCell returns the grid cell holding (lat, lon)
+func geoCell(lat, lon float64) int {
+	row := int(math.Floor(lat + 90))
+	if row > geoGridRows-1 {
+		row = geoGridRows - 1
+	}
+	col := int(math.Floor(lon+180)) % geoGridCols
+	return row*geoGridCols + col
+}
+
+// geoSearch narrows a candidate query to the area around a point. When Cells
+// is nil the area is too large to list cells, and only the latitude band
+// [MinLat, MaxLat] is applied. Both are conservative: the exact distance is
+// checked afterwards.
+type geoSearch struct {
+	Cells  []int
+	MinLat float64
+	MaxLat float64
+}
+
+// newGeoSearch covers every point within radiusKm of (lat, lon). Longitudes
+// wrap around the ±180° meridian, and a circle containing a pole takes every
+// longitude of the rows it spans. A non-positive radius means no limit, and
+// nil is returned.
+func newGeoSearch(lat, lon, radiusKm float64) *geoSearch {
+	if radiusKm <= 0 {
+		return nil
+	}
+	angular := radiusKm / earthRadiusKm // radians
+	dLat := angular * 180 / math.Pi
+
+	s := &geoSearch{MinLat: math.Max(lat-dLat, -90), MaxLat: math.Min(lat+dLat, 90)}
+
+	// Half-width in longitude of the circle. It reaches 180° once the circle
+	// contains a pole, i.e. sin(angular) >= cos(lat).
+	dLon := 180.0
+	if s.MinLat > -90 && s.MaxLat < 90 {
+		if ratio := math.Sin(angular) / math.Cos(lat*math.Pi/180); ratio < 1 {
+			dLon = math.Asin(ratio) * 180 / math.Pi
+		}
+	}
+
+	minRow, maxRow := geoCell(s.MinLat, 0)/geoGridCols, geoCell(s.MaxLat, 0)/geoGridCols
+
+	var cols []int
+	if dLon >= 180 {
+		for c := 0; c < geoGridCols; c++ {
+			cols = append(cols, c)
+		}
+	} else {
+		first := int(math.Floor(lon - dLon + 180))
+		last := int(math.Floor(lon + dLon + 180))
+		if last-first+1 >= geoGridCols {
+			last = first + geoGridCols - 1
+		}
+		for c := first; c <= last; c++ {
+			cols = append(cols, ((c%geoGridCols)+geoGridCols)%geoGridCols)
+		}
+	}
+
+	if (maxRow-minRow+1)*len(cols) > geoMaxCells {
+		return s
+	}
+	for row := minRow; row <= maxRow; row++ {
+		for _, col := range cols {
+			s.Cells = append(s.Cells, row*geoGridCols+col)
+		}
+	}
+	return s
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// destination returns the point distanceKm away from (lat, lon) on the bearing
+func destination(lat, lon, bearingDeg, distanceKm float64) (float64, float64) {
+	rad := math.Pi / 180
+	d := distanceKm / earthRadiusKm
+	lat1, lon1, b := lat*rad, lon*rad, bearingDeg*rad
+	lat2 := math.Asin(math.Sin(lat1)*math.Cos(d) + math.Cos(lat1)*math.Sin(d)*math.Cos(b))
+	lon2 := lon1 + math.Atan2(math.Sin(b)*math.Sin(d)*math.Cos(lat1), math.Cos(d)-math.Sin(lat1)*math.Sin(lat2))
+	lon2 = math.Mod(lon2/rad+540, 360) - 180
+	return lat2 / rad, lon2
+}
+
+func TestGeoCell(t *testing.T) {
+	cases := []struct {
+		lat, lon float64
+		want     int
+	}{
+		{-90, -180, 0},
+		{0, 0, 90*geoGridCols + 180},
+		{90, 180, 179 * geoGridCols}, // top row, lon 180 wraps to column 0
+		{59.43, 24.75, 149*geoGridCols + 204},
+		{-0.5, -179.5, 89 * geoGridCols},
+	}
+	for _, tc := range cases {
+		if got := geoCell(tc.lat, tc.lon); got != tc.want {
+			t.Errorf("geoCell(%v, %v) = %d, want %d", tc.lat, tc.lon, got, tc.want)
+		}
+	}
+}
+
+func TestGeoSearchCoversRadius(t *testing.T) {
+	centres := []struct {
+		name     string
+		lat, lon float64
+		radiusKm float64
+	}{
+		{"tallinn", 59.43, 24.75, 50},
+		{"antimeridian east", -17.7, 179.6, 120},
+		{"antimeridian west", 65.0, -179.9, 300},
+		{"near north pole", 89.2, 10, 200},
+		{"near south pole", -88.5, -120, 400},
+		{"wide search", 10, 0, 3000},
+	}
+	rng := rand.New(rand.NewSource(1))
+	for _, c := range centres {
+		s := newGeoSearch(c.lat, c.lon, c.radiusKm)
+		cells := map[int]bool{}
+		for _, cell := range s.Cells {
+			cells[cell] = true
+		}
+		for i := 0; i < 2000; i++ {
+			lat, lon := destination(c.lat, c.lon, rng.Float64()*360, rng.Float64()*c.radiusKm)
+			if lat < s.MinLat || lat > s.MaxLat {
+				t.Fatalf("%s: %v,%v outside latitude band [%v, %v]", c.name, lat, lon, s.MinLat, s.MaxLat)
+			}
+			if s.Cells != nil && !cells[geoCell(lat, lon)] {
+				t.Fatalf("%s: %v,%v (%.1f km) not covered", c.name, lat, lon, haversine(c.lat, c.lon, lat, lon))
+			}
+		}
+	}
+}
+
+func TestGeoSearchShape(t *testing.T) {
+	if newGeoSearch(10, 10, 0) != nil {
+		t.Fatal("an unlimited radius needs no area")
+	}
+
+	// across the antimeridian both edge columns are used, not the whole row
+	s := newGeoSearch(0, 179.9, 50)
+	if len(s.Cells) > 9 {
+		t.Fatalf("expected a handful of cells, got %d", len(s.Cells))
+	}
+	if !containsInt(s.Cells, geoCell(0, -179.9)) {
+		t.Fatal("expected the western side of the antimeridian to be covered")
+	}
+
+	// a circle containing the pole spans every longitude
+	s = newGeoSearch(89.5, 0, 100)
+	if len(s.Cells) != 2*geoGridCols {
+		t.Fatalf("expected two full rows, got %d cells", len(s.Cells))
+	}
+
+	// a very large radius falls back to the latitude band
+	s = newGeoSearch(0, 0, 8000)
+	if s.Cells != nil || s.MinLat > -71 || s.MaxLat < 71 {
+		t.Fatalf("expected a latitude band only, got %d cells [%v, %v]", len(s.Cells), s.MinLat, s.MaxLat)
+	}
+}
+
+func containsInt(list []int, v int) bool {
+	for _, x := range list {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
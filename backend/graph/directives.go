@@ -0,0 +1,108 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gitea.kood.tech/petrkubec/match-me/backend/graph/model"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// roleRank orders the roles from least to most privileged, as in the main
+// package. Tokens without a role claim count as USER.
+var roleRank = map[model.Role]int{model.RoleUser: 0, model.RoleModerator: 1, model.RoleAdmin: 2}
+
+// Directives wires the schema directives into the executable schema
+func Directives() DirectiveRoot {
+	return DirectiveRoot{HasRole: HasRole, ChatMember: ChatMember, CanViewUser: CanViewUser}
+}
+
+// HasRole implements @hasRole: the field resolves only for users whose token
+// carries the role or a higher one
+func HasRole(ctx context.Context, obj interface{}, next graphql.Resolver, role model.Role) (interface{}, error) {
+	if _, err := extractUserIDFromContext(ctx); err != nil {
+		return nil, err
+	}
+	if roleRank[roleFromContext(ctx)] < roleRank[role] {
+		return nil, &gqlerror.Error{
+			Message:    "forbidden",
+			Extensions: map[string]interface{}{"code": "FORBIDDEN"},
+		}
+	}
+	return next(ctx)
+}
+
+// roleFromContext returns the role claim set by AuthMiddleware
+func roleFromContext(ctx context.Context) model.Role {
+	role, _ := ctx.Value(roleKey).(string)
+	if r := model.Role(strings.ToUpper(role)); r.IsValid() {
+		return r
+	}
+	return model.RoleUser
+}
+
+// ChatMember implements @chatMember: the field resolves only for the two
+// participants of the chat whose ID is in the argument arg. Everyone else gets
+// the same error whether or not the chat exists.
+func ChatMember(ctx context.Context, obj interface{}, next graphql.Resolver, arg string) (interface{}, error) {
+	userID, err := extractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	chatID, err := idArg(ctx, arg)
+	if err != nil {
+		return nil, fmt.Errorf("invalid chat ID: %w", err)
+	}
+	if PolicySvc == nil {
+		return nil, fmt.Errorf("access policy unavailable")
+	}
+	member, err := PolicySvc.IsChatMember(ctx, userID, chatID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify chat access: %w", err)
+	}
+	if !member {
+		return nil, &gqlerror.Error{
+			Message:    "chat not found or access denied",
+			Extensions: map[string]interface{}{"code": "NOT_FOUND"},
+		}
+	}
+	return next(ctx)
+}
+
+// CanViewUser implements @canViewUser: the field resolves to null unless the
+// viewer may see the user whose ID is in the argument arg, so hidden users
+// look the same as users without a profile
+func CanViewUser(ctx context.Context, obj interface{}, next graphql.Resolver, arg string) (interface{}, error) {
+	viewerID, err := extractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	targetID, err := idArg(ctx, arg)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+	if PolicySvc == nil {
+		return nil, fmt.Errorf("access policy unavailable")
+	}
+	allowed, err := PolicySvc.CanViewUser(ctx, viewerID, targetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify access: %w", err)
+	}
+	if !allowed {
+		return nil, nil
+	}
+	return next(ctx)
+}
+
+// idArg reads the numeric ID argument name of the field being resolved
+func idArg(ctx context.Context, name string) (int, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return 0, fmt.Errorf("no argument %s", name)
+	}
+	s, _ := fc.Args[name].(string)
+	return strconv.Atoi(s)
+}
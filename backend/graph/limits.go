@@ -0,0 +1,250 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Error codes for operations rejected before they run
+const (
+	errDepthLimit      = "DEPTH_LIMIT_EXCEEDED"
+	errComplexityLimit = "COMPLEXITY_LIMIT_EXCEEDED" // set by gqlgen's ComplexityLimit
+	errRateLimited     = "RATE_LIMITED"
+)
+
+func init() {
+	// Oversized operations are rejected like invalid ones, with a 422
+	errcode.RegisterErrorType(errDepthLimit, errcode.KindProtocol)
+	errcode.RegisterErrorType(errComplexityLimit, errcode.KindProtocol)
+}
+
+// Limits bound what a single operation may cost and how many a user may run.
+// A zero field disables that limit.
+type Limits struct {
+	MaxDepth            int // field nesting, introspection excluded
+	MaxComplexity       int // as scored by Complexity
+	OperationsPerMinute int // per authenticated user
+}
+
+// DefaultLimits leave room for every query the frontend sends
+var DefaultLimits = Limits{MaxDepth: 10, MaxComplexity: 2000, OperationsPerMinute: 300}
+
+// listEstimate is the assumed length of lists that take no size argument
+const listEstimate = 20
+
+// Complexity scores list fields by how many items they can return, so
+// nesting lists multiplies the cost. Other fields cost 1 plus their
+// selections.
+func Complexity() ComplexityRoot {
+	var c ComplexityRoot
+	list := func(childComplexity int) int { return 1 + listEstimate*childComplexity }
+	sized := func(limit *int, def, max int) int {
+		if limit == nil || *limit < 1 {
+			return def
+		}
+		return min(*limit, max)
+	}
+
+	c.Query.Recommendations = list
+	c.Query.Connections = list
+	c.Query.ConnectionRequests = list
+	c.Query.BlockedUsers = list
+	c.Query.Chats = list
+	c.Chat.Messages = list
+	c.ChatMessage.Edits = list
+	c.Query.ChatMessages = func(childComplexity int, chatID string, limit *int, offset *int) int {
+		return 1 + sized(limit, 50, 200)*childComplexity
+	}
+	c.Query.CitySearch = func(childComplexity int, query string, limit *int) int {
+		return 1 + sized(limit, 10, 50)*childComplexity
+	}
+	c.Query.RecommendationsConnection = func(childComplexity int, first *int, after *string) int {
+		return 1 + sized(first, 10, 50)*childComplexity
+	}
+	return c
+}
+
+// UseLimits installs the limits on the server. Rate limiting runs first, so
+// rejected operations are never scored.
+func UseLimits(srv *handler.Server, l Limits) {
+	if l.OperationsPerMinute > 0 {
+		srv.Use(&rateLimit{limiter: newOperationLimiter(l.OperationsPerMinute, time.Minute)})
+	}
+	if l.MaxDepth > 0 {
+		srv.Use(depthLimit{max: l.MaxDepth})
+	}
+	if l.MaxComplexity > 0 {
+		srv.Use(extension.FixedComplexityLimit(l.MaxComplexity))
+	}
+}
+
+// depthLimit rejects operations nested deeper than max
+type depthLimit struct {
+	max int
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationContextMutator
+} = depthLimit{}
+
+func (depthLimit) ExtensionName() string                          { return "DepthLimit" }
+func (depthLimit) Validate(schema graphql.ExecutableSchema) error { return nil }
+
+func (d depthLimit) MutateOperationContext(ctx context.Context, opCtx *graphql.OperationContext) *gqlerror.Error {
+	op := opCtx.Doc.Operations.ForName(opCtx.OperationName)
+	if op == nil {
+		return nil
+	}
+	if depth := selectionDepth(op.SelectionSet); depth > d.max {
+		err := gqlerror.Errorf("operation has depth %d, which exceeds the limit of %d", depth, d.max)
+		errcode.Set(err, errDepthLimit)
+		return err
+	}
+	return nil
+}
+
+// selectionDepth is the deepest field nesting in the selection set, looking
+// through fragments. Introspection fields do not count: the playground's
+// schema query is deep but cheap.
+func selectionDepth(set ast.SelectionSet) int {
+	depth := 0
+	for _, sel := range set {
+		var d int
+		switch sel := sel.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(sel.Name, "__") {
+				continue
+			}
+			d = 1 + selectionDepth(sel.SelectionSet)
+		case *ast.InlineFragment:
+			d = selectionDepth(sel.SelectionSet)
+		case *ast.FragmentSpread:
+			if sel.Definition != nil {
+				d = selectionDepth(sel.Definition.SelectionSet)
+			}
+		}
+		depth = max(depth, d)
+	}
+	return depth
+}
+
+// rateLimit caps the operations each authenticated user may start. Anonymous
+// operations (register, login) are not counted here.
+type rateLimit struct {
+	limiter *operationLimiter
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationContextMutator
+} = &rateLimit{}
+
+func (*rateLimit) ExtensionName() string                          { return "RateLimit" }
+func (*rateLimit) Validate(schema graphql.ExecutableSchema) error { return nil }
+
+func (r *rateLimit) MutateOperationContext(ctx context.Context, opCtx *graphql.OperationContext) *gqlerror.Error {
+	userID, err := extractUserIDFromContext(ctx)
+	if err != nil {
+		return nil
+	}
+	if ok, retry := r.limiter.allow(userID); !ok {
+		secs := int((retry + time.Second - 1) / time.Second)
+		return &gqlerror.Error{
+			Message:    fmt.Sprintf("rate limited, retry in %ds", secs),
+			Extensions: map[string]interface{}{"code": errRateLimited, "retryAfter": secs},
+		}
+	}
+	return nil
+}
+
+// operationLimiter counts operations per user in fixed windows
+type operationLimiter struct {
+	mu        sync.Mutex
+	limit     int
+	window    time.Duration
+	now       func() time.Time
+	users     map[int]*opWindow
+	lastSweep time.Time
+}
+
+type opWindow struct {
+	start time.Time
+	count int
+}
+
+func newOperationLimiter(limit int, window time.Duration) *operationLimiter {
+	return &operationLimiter{limit: limit, window: window, now: time.Now, users: make(map[int]*opWindow)}
+}
+
+// allow counts an operation for the user, or returns how long until the
+// next one is allowed
+func (l *operationLimiter) allow(userID int) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if now.Sub(l.lastSweep) > l.window {
+		for id, w := range l.users {
+			if now.Sub(w.start) >= l.window {
+				delete(l.users, id)
+			}
+		}
+		l.lastSweep = now
+	}
+
+	w := l.users[userID]
+	if w == nil || now.Sub(w.start) >= l.window {
+		w = &opWindow{start: now}
+		l.users[userID] = w
+	}
+	if w.count >= l.limit {
+		return false, w.start.Add(l.window).Sub(now)
+	}
+	w.count++
+	return true, 0
+}
+
+// allowClient applies LimitSvc's rule for the action to the client. It does
+// nothing when no limiter is set.
+func allowClient(ctx context.Context, action string) error {
+	if LimitSvc == nil {
+		return nil
+	}
+	if err := LimitSvc.AllowClient(ctx, action); err != nil {
+		if gqlErr := retryAfterError(err); gqlErr != nil {
+			return gqlErr
+		}
+		return err
+	}
+	return nil
+}
+
+// retryAfterError converts the main package's rate limit errors, which carry
+// a RetryAfterSeconds method, into GraphQL errors coded after them
+// (RATE_LIMITED, ACCOUNT_LOCKED). Other errors give nil.
+func retryAfterError(err error) *gqlerror.Error {
+	var rl interface {
+		error
+		RetryAfterSeconds() int
+	}
+	if !errors.As(err, &rl) {
+		return nil
+	}
+	secs := rl.RetryAfterSeconds()
+	return &gqlerror.Error{
+		Message:    fmt.Sprintf("%s, retry in %ds", strings.ReplaceAll(rl.Error(), "_", " "), secs),
+		Extensions: map[string]interface{}{"code": strings.ToUpper(rl.Error()), "retryAfter": secs},
+	}
+}
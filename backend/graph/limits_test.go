@@ -0,0 +1,109 @@
+package graph
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// limitedServer serves the schema with the limits. Rejected operations never
+// reach a resolver, so no database is needed.
+func limitedServer(l Limits) http.Handler {
+	srv := handler.New(NewExecutableSchema(Config{
+		Resolvers:  &Resolver{},
+		Directives: Directives(),
+		Complexity: Complexity(),
+	}))
+	srv.AddTransport(transport.POST{})
+	srv.Use(extension.Introspection{})
+	UseLimits(srv, l)
+	return srv
+}
+
+// errorCodes posts the query as user 1 and returns the extensions.code of
+// each error in the response
+func errorCodes(t *testing.T, srv http.Handler, query string) []string {
+	t.Helper()
+	body, _ := json.Marshal(map[string]string{"query": query})
+	req := httptest.NewRequest(http.MethodPost, "/graphql", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	srv.ServeHTTP(w, req.WithContext(context.WithValue(req.Context(), userIDKey, 1)))
+
+	var resp struct {
+		Errors []struct {
+			Message    string                 `json:"message"`
+			Extensions map[string]interface{} `json:"extensions"`
+		} `json:"errors"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	var codes []string
+	for _, e := range resp.Errors {
+		code, _ := e.Extensions["code"].(string)
+		codes = append(codes, code)
+	}
+	return codes
+}
+
+func TestDepthLimit(t *testing.T) {
+	srv := limitedServer(Limits{MaxDepth: 3})
+
+	assert.Empty(t, errorCodes(t, srv, `{ __typename }`))
+	assert.Empty(t, errorCodes(t, srv, `{ __schema { types { fields { type { ofType { name } } } } } }`),
+		"introspection does not count")
+	assert.Equal(t, []string{errDepthLimit},
+		errorCodes(t, srv, `{ me { profile { user { id } } } }`))
+	assert.Equal(t, []string{errDepthLimit},
+		errorCodes(t, srv, `{ me { ...deep } } fragment deep on User { profile { user { id } } }`),
+		"fragments are followed")
+}
+
+func TestComplexityLimit(t *testing.T) {
+	srv := limitedServer(Limits{MaxComplexity: 500})
+
+	assert.Equal(t, []string{errComplexityLimit},
+		errorCodes(t, srv, `{ chats { user1 { id } messages { id content } } }`),
+		"nested lists multiply")
+	assert.Equal(t, []string{errComplexityLimit},
+		errorCodes(t, srv, `{ chatMessages(chatID: "1", limit: 200) { id content senderID } }`),
+		"sized lists are scored by their limit")
+}
+
+func TestRateLimit(t *testing.T) {
+	srv := limitedServer(Limits{OperationsPerMinute: 2})
+
+	assert.Empty(t, errorCodes(t, srv, `{ __typename }`))
+	assert.Empty(t, errorCodes(t, srv, `{ __typename }`))
+	assert.Equal(t, []string{errRateLimited}, errorCodes(t, srv, `{ __typename }`))
+}
+
+func TestOperationLimiter(t *testing.T) {
+	now := time.Unix(0, 0)
+	l := newOperationLimiter(2, time.Minute)
+	l.now = func() time.Time { return now }
+
+	ok, _ := l.allow(1)
+	assert.True(t, ok)
+	ok, _ = l.allow(1)
+	assert.True(t, ok)
+	ok, retry := l.allow(1)
+	assert.False(t, ok)
+	assert.Equal(t, time.Minute, retry)
+
+	ok, _ = l.allow(2)
+	assert.True(t, ok, "users are counted separately")
+
+	now = now.Add(time.Minute)
+	ok, _ = l.allow(1)
+	assert.True(t, ok, "the window resets")
+}
@@ -9,9 +9,38 @@ import (
 	"strconv"
 )
 
+type AdminStats struct {
+	Users            int `json:"users"`
+	CompleteProfiles int `json:"completeProfiles"`
+	ActiveUsers      int `json:"activeUsers"`
+	NewUsers         int `json:"newUsers"`
+	Moderators       int `json:"moderators"`
+	Admins           int `json:"admins"`
+	BannedUsers      int `json:"bannedUsers"`
+	SuspendedUsers   int `json:"suspendedUsers"`
+	OpenReports      int `json:"openReports"`
+	Connections      int `json:"connections"`
+	MessagesLastDay  int `json:"messagesLastDay"`
+}
+
+type AdminUser struct {
+	ID              string  `json:"id"`
+	Email           string  `json:"email"`
+	Role            Role    `json:"role"`
+	CreatedAt       string  `json:"createdAt"`
+	LastOnline      *string `json:"lastOnline,omitempty"`
+	BannedAt        *string `json:"bannedAt,omitempty"`
+	SuspendedUntil  *string `json:"suspendedUntil,omitempty"`
+	DisplayName     *string `json:"displayName,omitempty"`
+	ProfileComplete bool    `json:"profileComplete"`
+	OpenReports     int     `json:"openReports"`
+	ReportsFiled    int     `json:"reportsFiled"`
+}
+
 type AuthResult struct {
-	Token string `json:"token"`
-	User  *User  `json:"user"`
+	Token        string  `json:"token"`
+	RefreshToken *string `json:"refreshToken,omitempty"`
+	User         *User   `json:"user"`
 }
 
 type Bio struct {
@@ -45,13 +74,29 @@ type Chat struct {
 }
 
 type ChatMessage struct {
-	ID        string `json:"id"`
-	ChatID    string `json:"chatID"`
-	SenderID  string `json:"senderID"`
-	Content   string `json:"content"`
-	CreatedAt string `json:"createdAt"`
-	IsRead    bool   `json:"isRead"`
-	Sender    *User  `json:"sender"`
+	ID          string          `json:"id"`
+	ChatID      string          `json:"chatID"`
+	SenderID    string          `json:"senderID"`
+	Content     string          `json:"content"`
+	CreatedAt   string          `json:"createdAt"`
+	IsRead      bool            `json:"isRead"`
+	Sender      *User           `json:"sender"`
+	EditedAt    *string         `json:"editedAt,omitempty"`
+	DeletedAt   *string         `json:"deletedAt,omitempty"`
+	DeliveredAt *string         `json:"deliveredAt,omitempty"`
+	ReadAt      *string         `json:"readAt,omitempty"`
+	Edits       []*MessageEdit  `json:"edits"`
+	Reactions   []*Reaction     `json:"reactions"`
+	ReplyTo     *MessagePreview `json:"replyTo,omitempty"`
+	ClientMsgID *string         `json:"clientMsgID,omitempty"`
+}
+
+type City struct {
+	Name       string  `json:"name"`
+	Country    string  `json:"country"`
+	Lat        float64 `json:"lat"`
+	Lon        float64 `json:"lon"`
+	Population int     `json:"population"`
 }
 
 type Connection struct {
@@ -65,9 +110,48 @@ type Connection struct {
 	TargetUser   *User            `json:"targetUser"`
 }
 
+type DimensionExplanation struct {
+	Dimension     string   `json:"dimension"`
+	Weight        int      `json:"weight"`
+	Points        int      `json:"points"`
+	Shared        []string `json:"shared"`
+	Groups        []string `json:"groups"`
+	Keywords      []string `json:"keywords"`
+	Complementary []string `json:"complementary"`
+	Distance      *string  `json:"distance,omitempty"`
+}
+
+type MessageEdit struct {
+	Content  string `json:"content"`
+	EditedAt string `json:"editedAt"`
+}
+
+type MessagePreview struct {
+	ID       string `json:"id"`
+	SenderID string `json:"senderID"`
+	Content  string `json:"content"`
+	Deleted  bool   `json:"deleted"`
+}
+
+type MessageStatusUpdate struct {
+	ChatID      string        `json:"chatID"`
+	SenderID    string        `json:"senderID"`
+	RecipientID string        `json:"recipientID"`
+	MessageIDs  []string      `json:"messageIDs"`
+	Status      MessageStatus `json:"status"`
+	At          string        `json:"at"`
+}
+
 type Mutation struct {
 }
 
+type PageInfo struct {
+	HasNextPage     bool    `json:"hasNextPage"`
+	HasPreviousPage bool    `json:"hasPreviousPage"`
+	StartCursor     *string `json:"startCursor,omitempty"`
+	EndCursor       *string `json:"endCursor,omitempty"`
+}
+
 type PresenceUpdate struct {
 	UserID     string  `json:"userID"`
 	IsOnline   bool    `json:"isOnline"`
@@ -99,6 +183,42 @@ type ProfileInput struct {
 type Query struct {
 }
 
+type Reaction struct {
+	Emoji       string `json:"emoji"`
+	Count       int    `json:"count"`
+	ReactedByMe bool   `json:"reactedByMe"`
+}
+
+type ReactionUpdate struct {
+	MessageID string `json:"messageID"`
+	UserID    string `json:"userID"`
+	Emoji     string `json:"emoji"`
+	Added     bool   `json:"added"`
+	Count     int    `json:"count"`
+}
+
+type RecommendationConnection struct {
+	Edges      []*RecommendationEdge `json:"edges"`
+	PageInfo   *PageInfo             `json:"pageInfo"`
+	TotalCount int                   `json:"totalCount"`
+}
+
+type RecommendationEdge struct {
+	Cursor          string                     `json:"cursor"`
+	Node            *User                      `json:"node"`
+	Score           int                        `json:"score"`
+	ScorePercentage float64                    `json:"scorePercentage"`
+	Distance        *string                    `json:"distance,omitempty"`
+	Explanation     *RecommendationExplanation `json:"explanation,omitempty"`
+}
+
+type RecommendationExplanation struct {
+	UserID          string                  `json:"userID"`
+	Score           int                     `json:"score"`
+	ScorePercentage float64                 `json:"scorePercentage"`
+	Dimensions      []*DimensionExplanation `json:"dimensions"`
+}
+
 type Subscription struct {
 }
 
@@ -175,3 +295,115 @@ func (e ConnectionStatus) MarshalJSON() ([]byte, error) {
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
+
+type MessageStatus string
+
+const (
+	MessageStatusDelivered MessageStatus = "DELIVERED"
+	MessageStatusRead      MessageStatus = "READ"
+)
+
+var AllMessageStatus = []MessageStatus{
+	MessageStatusDelivered,
+	MessageStatusRead,
+}
+
+func (e MessageStatus) IsValid() bool {
+	switch e {
+	case MessageStatusDelivered, MessageStatusRead:
+		return true
+	}
+	return false
+}
+
+func (e MessageStatus) String() string {
+	return string(e)
+}
+
+func (e *MessageStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MessageStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MessageStatus", str)
+	}
+	return nil
+}
+
+func (e MessageStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *MessageStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e MessageStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type Role string
+
+const (
+	RoleUser      Role = "USER"
+	RoleModerator Role = "MODERATOR"
+	RoleAdmin     Role = "ADMIN"
+)
+
+var AllRole = []Role{
+	RoleUser,
+	RoleModerator,
+	RoleAdmin,
+}
+
+func (e Role) IsValid() bool {
+	switch e {
+	case RoleUser, RoleModerator, RoleAdmin:
+		return true
+	}
+	return false
+}
+
+func (e Role) String() string {
+	return string(e)
+}
+
+func (e *Role) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Role(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Role", str)
+	}
+	return nil
+}
+
+func (e Role) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *Role) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e Role) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
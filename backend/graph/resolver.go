@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -13,6 +14,7 @@ import (
 	"strings"
 	"time"
 
+	"gitea.kood.tech/petrkubec/match-me/backend/events"
 	"gitea.kood.tech/petrkubec/match-me/backend/graph/model"
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/golang-jwt/jwt/v5"
@@ -25,6 +27,13 @@ var jwtSecret []byte
 type AuthService interface {
 	Register(ctx context.Context, email, password string) (string, int, error)
 	Login(ctx context.Context, email, password string) (string, int, error)
+	ValidateToken(ctx context.Context, tokenStr string) (int, error)
+	ValidateTokenRole(ctx context.Context, tokenStr string) (int, string, error)
+	Logout(ctx context.Context, tokenStr string) error
+	LogoutAll(ctx context.Context, userID int) error
+	IssueRefreshToken(ctx context.Context, userID int) (string, error)
+	Refresh(ctx context.Context, refreshToken string) (string, string, int, error)
+	RevokeRefreshToken(ctx context.Context, refreshToken string) error
 }
 
 type ConnectionService interface {
@@ -33,14 +42,134 @@ type ConnectionService interface {
 	DeclineConnection(ctx context.Context, me, targetID int) (string, error)
 }
 
+// BlockService manages blocks between users, which apply in both directions
+type BlockService interface {
+	BlockUser(ctx context.Context, me, targetID int) error
+	UnblockUser(ctx context.Context, me, targetID int) error
+	GetBlocked(ctx context.Context, userID int) ([]int, error)
+	IsBlocked(ctx context.Context, a, b int) (bool, error)
+}
+
+// AdminService backs the admin fields guarded by @hasRole(role: ADMIN)
+type AdminService interface {
+	Stats(ctx context.Context) (*model.AdminStats, error)
+	// User looks the account up by ID, or by email when userID is 0
+	User(ctx context.Context, userID int, email string) (*model.AdminUser, error)
+	SetRole(ctx context.Context, adminID, userID int, role, reason string) error
+}
+
+// AccessPolicy is the main package's visibility policy, shared with the REST
+// API and applied by the @chatMember and @canViewUser directives
+type AccessPolicy interface {
+	CanViewUser(ctx context.Context, viewerID, targetID int) (bool, error)
+	IsChatMember(ctx context.Context, userID, chatID int) (bool, error)
+}
+
+// RateLimiter is the main package's rate limiter. AllowClient takes a token
+// for the action from the client IP's bucket; rejections carry a
+// RetryAfterSeconds method.
+type RateLimiter interface {
+	AllowClient(ctx context.Context, action string) error
+}
+
+// MessageService sends, edits and deletes chat messages on behalf of their
+// sender. Errors are the main package's codes: not_found, not_sender,
+// message_deleted, empty_message, blocked.
+type MessageService interface {
+	// SendMessage stores and delivers a message; a non-zero replyToID quotes
+	// a message of the same chat, failing with invalid_reply otherwise. A
+	// clientMsgID the user sent before returns that message again.
+	SendMessage(ctx context.Context, userID, targetID int, content string, replyToID int64, clientMsgID string) (*model.ChatMessage, error)
+	EditMessage(ctx context.Context, userID int, messageID int64, content string) (*model.ChatMessage, error)
+	DeleteMessage(ctx context.Context, userID int, messageID int64) (*model.ChatMessage, error)
+	// MessageEdits is the edit history of a message in one of userID's chats
+	MessageEdits(ctx context.Context, userID int, messageID int64) ([]*model.MessageEdit, error)
+
+	// AddReaction and RemoveReaction return the message's reactions after
+	// the change; errors add invalid_reaction
+	AddReaction(ctx context.Context, userID int, messageID int64, emoji string) ([]*model.Reaction, error)
+	RemoveReaction(ctx context.Context, userID int, messageID int64, emoji string) ([]*model.Reaction, error)
+	// Reactions counts the reactions to the messages as seen by userID
+	Reactions(ctx context.Context, userID int, messageIDs []int64) (map[int64][]*model.Reaction, error)
+	// Previews returns quote previews of messages in userID's chats
+	Previews(ctx context.Context, userID int, messageIDs []int64) (map[int64]*model.MessagePreview, error)
+
+	// MarkChatRead marks the peer's messages in the chat as read and
+	// MarkDelivered acknowledges a received message and the earlier ones;
+	// both send the peer a receipt
+	MarkChatRead(ctx context.Context, userID, chatID int) error
+	MarkDelivered(ctx context.Context, userID int, messageID int64) error
+}
+
+// ModerationService files abuse reports
+type ModerationService interface {
+	ReportUser(ctx context.Context, reporterID, reportedID int, messageID *int64, reason string) (int, error)
+}
+
 type RecommendationService interface {
 	DismissRecommendation(ctx context.Context, userID, dismissedUserID int) error
+	RecommendationPage(ctx context.Context, userID int, after string, first int) (*RecommendationPage, error)
+	// Explanation returns ErrNotRecommended unless candidateID is currently recommended to userID
+	Explanation(ctx context.Context, userID, candidateID int) (*model.RecommendationExplanation, error)
+	// Explanations explains the given candidates, typically one page of the feed
+	Explanations(ctx context.Context, userID int, candidateIDs []int) (map[int]*model.RecommendationExplanation, error)
+}
+
+// ErrNotRecommended is returned for explanations of users outside the feed
+var ErrNotRecommended = errors.New("user is not currently recommended")
+
+// RecommendationPage is one page of the ranked recommendation feed
+type RecommendationPage struct {
+	Items           []RecommendationItem
+	HasNextPage     bool
+	HasPreviousPage bool
+	TotalCount      int
+}
+
+// RecommendationItem is a ranked user with the cursor pointing just past it
+type RecommendationItem struct {
+	UserID          int
+	Score           int
+	ScorePercentage float64
+	Distance        *string // distance range, nil when unknown
+	Cursor          string
+}
+
+// PresenceService tracks live connections so subscribers count as online
+type PresenceService interface {
+	Connect(userID int)
+	Disconnect(userID int)
+}
+
+// TypingService tracks typing indicators with server-side expiry
+type TypingService interface {
+	Start(chatID, userID, peerID int)
+	Stop(userID, peerID int)
+}
+
+// GeoService geocodes cities with the offline gazetteer
+type GeoService interface {
+	// ResolveLocation reconciles a city with the coordinates sent alongside
+	// it (nil when absent), returning the city name and coordinates to store
+	ResolveLocation(city string, lat, lon *float64) (string, float64, float64, error)
+	SearchCities(query string, limit int) []*model.City
+	// DisplayLocation is the coarse point shown to users other than userID
+	DisplayLocation(userID int, lat, lon float64) (float64, float64)
 }
 
 var (
 	AuthSvc           AuthService
 	ConnectionsSvc    ConnectionService
 	RecommendationSvc RecommendationService
+	PresenceSvc       PresenceService
+	TypingSvc         TypingService
+	GeoSvc            GeoService
+	BlockSvc          BlockService
+	ModerationSvc     ModerationService
+	AdminSvc          AdminService
+	PolicySvc         AccessPolicy
+	LimitSvc          RateLimiter
+	MessageSvc        MessageService
 )
 
 // SetJWTSecret sets the JWT secret for the GraphQL resolvers
@@ -58,6 +187,98 @@ func extractUserIDFromContext(ctx context.Context) (int, error) {
 	return 0, fmt.Errorf("authentication required")
 }
 
+// viewableProfile hides the exact location of someone else's profile behind
+// GeoSvc.DisplayLocation. Loaded profiles may be cached, so a copy is changed.
+func viewableProfile(ctx context.Context, profile *model.Profile) *model.Profile {
+	if profile == nil || (profile.LocationLat == nil && profile.LocationLon == nil) {
+		return profile
+	}
+	viewerID, _ := extractUserIDFromContext(ctx)
+	ownerID, err := strconv.Atoi(profile.UserID)
+	if err == nil && ownerID == viewerID {
+		return profile
+	}
+	out := *profile
+	out.LocationLat, out.LocationLon = nil, nil
+	if err == nil && GeoSvc != nil && profile.LocationLat != nil && profile.LocationLon != nil {
+		lat, lon := GeoSvc.DisplayLocation(ownerID, *profile.LocationLat, *profile.LocationLon)
+		out.LocationLat, out.LocationLon = &lat, &lon
+	}
+	return &out
+}
+
+// canViewUser applies the shared access policy to fields nested under a User
+// that @canViewUser on the parent field does not cover (me, chat
+// participants, ...). Users always see themselves.
+func canViewUser(ctx context.Context, userID string) (bool, error) {
+	viewerID, err := extractUserIDFromContext(ctx)
+	if err != nil {
+		return false, err
+	}
+	targetID, err := strconv.Atoi(userID)
+	if err != nil {
+		return false, fmt.Errorf("invalid user ID: %w", err)
+	}
+	if viewerID == targetID {
+		return true, nil
+	}
+	if PolicySvc == nil {
+		return false, fmt.Errorf("access policy unavailable")
+	}
+	allowed, err := PolicySvc.CanViewUser(ctx, viewerID, targetID)
+	if err != nil {
+		return false, fmt.Errorf("failed to verify access: %w", err)
+	}
+	return allowed, nil
+}
+
+// blockedPair reports whether either user blocked the other
+func blockedPair(ctx context.Context, a, b int) (bool, error) {
+	if BlockSvc == nil {
+		return false, nil
+	}
+	return BlockSvc.IsBlocked(ctx, a, b)
+}
+
+// withoutBlocked relays a subscription channel to userID, dropping items
+// coming from (per from) a user who blocked userID or was blocked by them.
+// Blocks can be created while the subscription is open, so every item is
+// checked on the subscriber's goroutine rather than in the event bus.
+func withoutBlocked[T any](ctx context.Context, userID int, in <-chan T, from func(T) string) <-chan T {
+	if BlockSvc == nil {
+		return in
+	}
+	out := make(chan T, cap(in))
+	go func() {
+		defer close(out)
+		for item := range in {
+			if otherID, err := strconv.Atoi(from(item)); err == nil && otherID != userID {
+				if blocked, err := BlockSvc.IsBlocked(ctx, userID, otherID); err != nil || blocked {
+					continue
+				}
+			}
+			select {
+			case out <- item:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// trackPresence marks the user online for as long as the subscription context lives
+func trackPresence(ctx context.Context, userID int) {
+	if PresenceSvc == nil {
+		return
+	}
+	PresenceSvc.Connect(userID)
+	go func() {
+		<-ctx.Done()
+		PresenceSvc.Disconnect(userID)
+	}()
+}
+
 func createJWTToken(userID int) (string, error) {
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
 		"user_id": userID,
@@ -108,6 +329,12 @@ type contextKey string
 
 const userIDKey contextKey = "userID"
 
+// tokenKey holds the raw bearer token so Logout can revoke it
+const tokenKey contextKey = "token"
+
+// roleKey holds the role claim of the token ("user", "moderator" or "admin")
+const roleKey contextKey = "userRole"
+
 // getUserIDFromContext extracts the user ID from GraphQL context
 func getUserIDFromContext(ctx context.Context) (int, error) {
 	if userID, ok := ctx.Value(userIDKey).(int); ok && userID > 0 {
@@ -122,22 +349,55 @@ func AuthMiddleware(next http.Handler) http.Handler {
 		authHeader := r.Header.Get("Authorization")
 		if strings.HasPrefix(authHeader, "Bearer ") {
 			tokenStr := strings.TrimPrefix(authHeader, "Bearer ")
-			token, err := jwt.Parse(tokenStr, func(token *jwt.Token) (interface{}, error) {
-				return jwtSecret, nil
-			})
-			if err == nil && token.Valid {
-				if claims, ok := token.Claims.(jwt.MapClaims); ok {
-					if userID, ok := claims["user_id"].(float64); ok {
-						ctx := context.WithValue(r.Context(), userIDKey, int(userID))
-						r = r.WithContext(ctx)
-					}
-				}
+			userID, role, ok, err := validateToken(r.Context(), tokenStr)
+			if err != nil && (err.Error() == "account_banned" || err.Error() == "account_suspended") {
+				// Banned and suspended users are refused outright rather than
+				// served as anonymous
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusForbidden)
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"errors": []map[string]interface{}{{
+						"message":    err.Error(),
+						"extensions": map[string]string{"code": err.Error()},
+					}},
+				})
+				return
+			}
+			if ok {
+				ctx := context.WithValue(r.Context(), userIDKey, userID)
+				ctx = context.WithValue(ctx, tokenKey, tokenStr)
+				ctx = context.WithValue(ctx, roleKey, role)
+				r = r.WithContext(ctx)
 			}
 		}
 		next.ServeHTTP(w, r)
 	})
 }
 
+// validateToken uses the injected AuthService (which also consults the
+// revocation store and account status) and falls back to a plain signature
+// check. It returns the user and their role claim; the error explains a
+// rejection by the AuthService.
+func validateToken(ctx context.Context, tokenStr string) (int, string, bool, error) {
+	if AuthSvc != nil {
+		userID, role, err := AuthSvc.ValidateTokenRole(ctx, tokenStr)
+		return userID, role, err == nil, err
+	}
+
+	token, err := jwt.Parse(tokenStr, func(token *jwt.Token) (interface{}, error) {
+		return jwtSecret, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
+	if err == nil && token.Valid {
+		if claims, ok := token.Claims.(jwt.MapClaims); ok {
+			if userID, ok := claims["user_id"].(float64); ok {
+				role, _ := claims["role"].(string)
+				return int(userID), role, true, nil
+			}
+		}
+	}
+	return 0, "", false, nil
+}
+
 // User is the resolver for the user field.
 func (r *bioResolver) User(ctx context.Context, obj *model.Bio) (*model.User, error) {
 	// Use DataLoader if available
@@ -173,6 +433,9 @@ func (r *bioResolver) User(ctx context.Context, obj *model.Bio) (*model.User, er
 
 // Register is the resolver for the register field.
 func (r *mutationResolver) Register(ctx context.Context, email string, password string) (*model.AuthResult, error) {
+	if err := allowClient(ctx, "register"); err != nil {
+		return nil, err
+	}
 	if AuthSvc != nil {
 		token, newID, err := AuthSvc.Register(ctx, email, password)
 		if err != nil {
@@ -184,13 +447,18 @@ func (r *mutationResolver) Register(ctx context.Context, email string, password
 			}
 			return nil, err
 		}
+		refreshToken, err := AuthSvc.IssueRefreshToken(ctx, newID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to issue refresh token: %w", err)
+		}
 		user, err := r.getUserByID(newID)
 		if err != nil {
 			return nil, fmt.Errorf("failed to fetch created user: %w", err)
 		}
 		return &model.AuthResult{
-			Token: token,
-			User:  user,
+			Token:        token,
+			RefreshToken: &refreshToken,
+			User:         user,
 		}, nil
 	}
 
@@ -248,21 +516,32 @@ func (r *mutationResolver) Register(ctx context.Context, email string, password
 
 // Login is the resolver for the login field.
 func (r *mutationResolver) Login(ctx context.Context, email string, password string) (*model.AuthResult, error) {
+	if err := allowClient(ctx, "login"); err != nil {
+		return nil, err
+	}
 	if AuthSvc != nil {
 		token, userID, err := AuthSvc.Login(ctx, email, password)
 		if err != nil {
 			if err.Error() == "invalid_credentials" || err.Error() == "invalid_credentials" {
 				return nil, fmt.Errorf("invalid credentials")
 			}
+			if gqlErr := retryAfterError(err); gqlErr != nil {
+				return nil, gqlErr
+			}
 			return nil, err
 		}
+		refreshToken, err := AuthSvc.IssueRefreshToken(ctx, userID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to issue refresh token: %w", err)
+		}
 		user, err := r.getUserByID(userID)
 		if err != nil {
 			return nil, fmt.Errorf("failed to fetch user: %w", err)
 		}
 		return &model.AuthResult{
-			Token: token,
-			User:  user,
+			Token:        token,
+			RefreshToken: &refreshToken,
+			User:         user,
 		}, nil
 	}
 
@@ -312,11 +591,67 @@ func (r *mutationResolver) Login(ctx context.Context, email string, password str
 	}, nil
 }
 
+// RefreshToken is the resolver for the refreshToken field.
+func (r *mutationResolver) RefreshToken(ctx context.Context, refreshToken string) (*model.AuthResult, error) {
+	if AuthSvc == nil {
+		return nil, fmt.Errorf("token refresh is not available")
+	}
+	token, nextRefresh, userID, err := AuthSvc.Refresh(ctx, refreshToken)
+	if err != nil {
+		switch err.Error() {
+		case "missing_fields":
+			return nil, fmt.Errorf("refresh token is required")
+		case "invalid_refresh_token":
+			return nil, fmt.Errorf("invalid refresh token")
+		case "refresh_token_reused":
+			return nil, fmt.Errorf("refresh token reuse detected, please log in again")
+		}
+		return nil, err
+	}
+	user, err := r.getUserByID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch user: %w", err)
+	}
+	return &model.AuthResult{
+		Token:        token,
+		RefreshToken: &nextRefresh,
+		User:         user,
+	}, nil
+}
+
 // Logout is the resolver for the logout field.
-func (r *mutationResolver) Logout(ctx context.Context) (bool, error) {
-	// For JWT tokens, logout is typically handled client-side by removing the token
-	// We could implement a token blacklist here if needed
-	// For now, just return true as the client should remove the token
+func (r *mutationResolver) Logout(ctx context.Context, refreshToken *string) (bool, error) {
+	// Revoke the token used for this request (and the session's refresh
+	// token, if given). Without them, or without the revocation store,
+	// there is nothing to invalidate server-side.
+	if AuthSvc == nil {
+		return true, nil
+	}
+	if tokenStr, _ := ctx.Value(tokenKey).(string); tokenStr != "" {
+		if err := AuthSvc.Logout(ctx, tokenStr); err != nil {
+			return false, fmt.Errorf("failed to logout: %w", err)
+		}
+	}
+	if refreshToken != nil && *refreshToken != "" {
+		if err := AuthSvc.RevokeRefreshToken(ctx, *refreshToken); err != nil {
+			return false, fmt.Errorf("failed to logout: %w", err)
+		}
+	}
+	return true, nil
+}
+
+// LogoutAllSessions is the resolver for the logoutAllSessions field.
+func (r *mutationResolver) LogoutAllSessions(ctx context.Context) (bool, error) {
+	userID, err := extractUserIDFromContext(ctx)
+	if err != nil {
+		return false, err
+	}
+	if AuthSvc == nil {
+		return false, fmt.Errorf("session revocation is not available")
+	}
+	if err := AuthSvc.LogoutAll(ctx, userID); err != nil {
+		return false, fmt.Errorf("failed to logout all sessions: %w", err)
+	}
 	return true, nil
 }
 
@@ -327,6 +662,15 @@ func (r *mutationResolver) UpdateProfile(ctx context.Context, input model.Profil
 		return nil, err
 	}
 
+	// Geocode the city, or check it against the coordinates sent with it
+	if GeoSvc != nil && input.LocationCity != nil && *input.LocationCity != "" {
+		city, lat, lon, err := GeoSvc.ResolveLocation(*input.LocationCity, input.LocationLat, input.LocationLon)
+		if err != nil {
+			return nil, fmt.Errorf("invalid location: %w", err)
+		}
+		input.LocationCity, input.LocationLat, input.LocationLon = &city, &lat, &lon
+	}
+
 	// Check if profile exists, if not create it
 	var existingProfile model.Profile
 	err = r.DB.QueryRow(`
@@ -384,6 +728,7 @@ func (r *mutationResolver) UpdateProfile(ctx context.Context, input model.Profil
 			return nil, fmt.Errorf("failed to create profile: %w", err)
 		}
 
+		events.Default().PublishProfile(events.Profile{UserID: userID})
 		return profile, nil
 	} else if err != nil {
 		return nil, fmt.Errorf("failed to fetch existing profile: %w", err)
@@ -462,6 +807,7 @@ func (r *mutationResolver) UpdateProfile(ctx context.Context, input model.Profil
 		return nil, fmt.Errorf("failed to update profile: %w", err)
 	}
 
+	events.Default().PublishProfile(events.Profile{UserID: userID})
 	return &profile, nil
 }
 
@@ -673,6 +1019,7 @@ func (r *mutationResolver) UpdateBio(ctx context.Context, input model.BioInput)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update bio: %w", err)
 	}
+	events.Default().PublishProfile(events.Profile{UserID: userID})
 
 	// Set the returned string values
 	if collaborationInterests.Valid {
@@ -781,6 +1128,7 @@ func (r *mutationResolver) RequestConnection(ctx context.Context, targetUserID s
 	if err != nil {
 		return nil, fmt.Errorf("failed to create connection request: %w", err)
 	}
+	events.Default().PublishConnection(events.Connection{UserID: currentUserID, TargetID: targetID, Status: "pending"})
 
 	// Return the created connection
 	connection := &model.Connection{
@@ -884,6 +1232,7 @@ func (r *mutationResolver) RespondToConnection(ctx context.Context, connectionID
 		if err != nil {
 			return nil, fmt.Errorf("failed to accept connection: %w", err)
 		}
+		events.Default().PublishConnection(events.Connection{UserID: currentUserID, TargetID: userID, Status: "accepted"})
 
 		connection := &model.Connection{
 			ID:           fmt.Sprintf("%d", connID),
@@ -908,6 +1257,7 @@ func (r *mutationResolver) RespondToConnection(ctx context.Context, connectionID
 	if err != nil {
 		return nil, fmt.Errorf("failed to reject connection: %w", err)
 	}
+	events.Default().PublishConnection(events.Connection{UserID: currentUserID, TargetID: userID})
 
 	connection := &model.Connection{
 		ID:           fmt.Sprintf("%d", connID),
@@ -954,12 +1304,103 @@ func (r *mutationResolver) Disconnect(ctx context.Context, targetUserID string)
 	if err != nil {
 		return false, fmt.Errorf("failed to check disconnect result: %w", err)
 	}
+	if rowsAffected > 0 {
+		events.Default().PublishConnection(events.Connection{UserID: currentUserID, TargetID: targetID})
+	}
 
 	return rowsAffected > 0, nil
 }
 
+// BlockUser is the resolver for the blockUser field.
+func (r *mutationResolver) BlockUser(ctx context.Context, userID string) (bool, error) {
+	currentUserID, err := extractUserIDFromContext(ctx)
+	if err != nil {
+		return false, err
+	}
+	targetID, err := strconv.Atoi(userID)
+	if err != nil {
+		return false, fmt.Errorf("invalid user ID: %w", err)
+	}
+	if BlockSvc == nil {
+		return false, fmt.Errorf("blocking unavailable")
+	}
+
+	if err := BlockSvc.BlockUser(ctx, currentUserID, targetID); err != nil {
+		switch err.Error() {
+		case "not_found":
+			return false, fmt.Errorf("user not found")
+		case "invalid_target":
+			return false, fmt.Errorf("cannot block yourself")
+		}
+		return false, fmt.Errorf("failed to block user: %w", err)
+	}
+	return true, nil
+}
+
+// UnblockUser is the resolver for the unblockUser field.
+func (r *mutationResolver) UnblockUser(ctx context.Context, userID string) (bool, error) {
+	currentUserID, err := extractUserIDFromContext(ctx)
+	if err != nil {
+		return false, err
+	}
+	targetID, err := strconv.Atoi(userID)
+	if err != nil {
+		return false, fmt.Errorf("invalid user ID: %w", err)
+	}
+	if BlockSvc == nil {
+		return false, fmt.Errorf("blocking unavailable")
+	}
+
+	if err := BlockSvc.UnblockUser(ctx, currentUserID, targetID); err != nil {
+		if err.Error() == "invalid_target" {
+			return false, fmt.Errorf("cannot unblock yourself")
+		}
+		return false, fmt.Errorf("failed to unblock user: %w", err)
+	}
+	return true, nil
+}
+
+// ReportUser is the resolver for the reportUser field.
+func (r *mutationResolver) ReportUser(ctx context.Context, userID string, reason string, messageID *string) (string, error) {
+	currentUserID, err := extractUserIDFromContext(ctx)
+	if err != nil {
+		return "", err
+	}
+	targetID, err := strconv.Atoi(userID)
+	if err != nil {
+		return "", fmt.Errorf("invalid user ID: %w", err)
+	}
+	var msgID *int64
+	if messageID != nil {
+		id, err := strconv.ParseInt(*messageID, 10, 64)
+		if err != nil {
+			return "", fmt.Errorf("invalid message ID: %w", err)
+		}
+		msgID = &id
+	}
+	if ModerationSvc == nil {
+		return "", fmt.Errorf("reporting unavailable")
+	}
+
+	reportID, err := ModerationSvc.ReportUser(ctx, currentUserID, targetID, msgID, reason)
+	if err != nil {
+		switch err.Error() {
+		case "not_found":
+			return "", fmt.Errorf("user or message not found")
+		case "invalid_target":
+			return "", fmt.Errorf("cannot report yourself")
+		case "invalid_reason":
+			return "", fmt.Errorf("a reason of at most 1000 characters is required")
+		case "already_reported":
+			return "", fmt.Errorf("you already reported this")
+		}
+		return "", fmt.Errorf("failed to report user: %w", err)
+	}
+	return strconv.Itoa(reportID), nil
+}
+
 // SendMessage is the resolver for the sendMessage field.
-func (r *mutationResolver) SendMessage(ctx context.Context, targetUserID string, content string) (*model.ChatMessage, error) {
+func (r *mutationResolver) SendMessage(ctx context.Context, targetUserID string, content string, replyToID *string, clientMsgID *string) (*model.ChatMessage, error) {
 	currentUserID, err := extractUserIDFromContext(ctx)
 	if err != nil {
 		return nil, err
@@ -971,107 +1412,156 @@ func (r *mutationResolver) SendMessage(ctx context.Context, targetUserID string,
 		return nil, fmt.Errorf("invalid target user ID: %w", err)
 	}
 
+	var replyID int64
+	if replyToID != nil {
+		if replyID, err = strconv.ParseInt(*replyToID, 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid reply message ID: %w", err)
+		}
+	}
+
 	// Validate that content is not empty
 	if strings.TrimSpace(content) == "" {
 		return nil, fmt.Errorf("message content cannot be empty")
 	}
+	if MessageSvc == nil {
+		return nil, fmt.Errorf("messaging unavailable")
+	}
+
+	var clientID string
+	if clientMsgID != nil {
+		clientID = *clientMsgID
+	}
 
-	// Start transaction
-	tx, err := r.DB.Begin()
+	// The service checks blocks and the connection, and publishes the
+	// message to GraphQL subscribers and WebSocket clients of both users
+	msg, err := MessageSvc.SendMessage(ctx, currentUserID, targetID, content, replyID, clientID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to start transaction: %w", err)
+		return nil, sendMessageError(err)
 	}
-	defer func() {
-		if err != nil {
-			tx.Rollback()
-		} else {
-			tx.Commit()
-		}
-	}()
+	return msg, nil
+}
 
-	// Check that users are connected
-	var connectionExists bool
-	err = tx.QueryRow(`
-		SELECT EXISTS (
-			SELECT 1
-			FROM connections
-			WHERE status = 'accepted'
-				AND ((user_id = $1 AND target_user_id = $2) OR (user_id = $2 AND target_user_id = $1))
-		)
-	`, currentUserID, targetID).Scan(&connectionExists)
+// sendMessageError turns the main package's send errors into client
+// messages; a block looks the same as a missing connection
+func sendMessageError(err error) error {
+	switch msg := err.Error(); {
+	case msg == "blocked" || msg == "no accepted connection":
+		return fmt.Errorf("no accepted connection with target user")
+	case msg == "invalid_reply":
+		return fmt.Errorf("reply must quote a message in the same chat")
+	case msg == "message_deleted":
+		return fmt.Errorf("cannot reply to a deleted message")
+	case msg == "empty_message":
+		return fmt.Errorf("message content cannot be empty")
+	case msg == "invalid_client_msg_id":
+		return fmt.Errorf("clientMsgID must be at most 64 printable characters and not reused in another chat")
+	}
+	return fmt.Errorf("failed to save message: %w", err)
+}
+
+// EditMessage is the resolver for the editMessage field.
+func (r *mutationResolver) EditMessage(ctx context.Context, messageID string, content string) (*model.ChatMessage, error) {
+	currentUserID, err := extractUserIDFromContext(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to check connection: %w", err)
+		return nil, err
 	}
-	if !connectionExists {
-		return nil, fmt.Errorf("no accepted connection with target user")
+	msgID, err := strconv.ParseInt(messageID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid message ID: %w", err)
+	}
+	if MessageSvc == nil {
+		return nil, fmt.Errorf("messaging unavailable")
 	}
 
-	// Get or create chat
-	var chatID int
-	err = tx.QueryRow(`
-		SELECT id
-		FROM chats
-		WHERE user1_id = LEAST($1::int, $2::int) AND user2_id = GREATEST($1::int, $2::int)
-	`, currentUserID, targetID).Scan(&chatID)
-
-	if err == sql.ErrNoRows {
-		// Create new chat
-		err = tx.QueryRow(`
-			INSERT INTO chats (user1_id, user2_id)
-			VALUES (LEAST($1::int, $2::int), GREATEST($1::int, $2::int))
-			RETURNING id
-		`, currentUserID, targetID).Scan(&chatID)
-		if err != nil {
-			return nil, fmt.Errorf("failed to create chat: %w", err)
-		}
-	} else if err != nil {
-		return nil, fmt.Errorf("failed to get chat: %w", err)
+	msg, err := MessageSvc.EditMessage(ctx, currentUserID, msgID, content)
+	if err != nil {
+		return nil, messageChangeError("edit", err)
 	}
+	return msg, nil
+}
 
-	// Insert message
-	var msgID int64
-	var createdAt time.Time
-	err = tx.QueryRow(`
-		INSERT INTO messages (chat_id, sender_id, content)
-		VALUES ($1, $2, $3)
-		RETURNING id, created_at
-	`, chatID, currentUserID, content).Scan(&msgID, &createdAt)
+// DeleteMessage is the resolver for the deleteMessage field.
+func (r *mutationResolver) DeleteMessage(ctx context.Context, messageID string) (*model.ChatMessage, error) {
+	currentUserID, err := extractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	msgID, err := strconv.ParseInt(messageID, 10, 64)
 	if err != nil {
-		return nil, fmt.Errorf("failed to save message: %w", err)
+		return nil, fmt.Errorf("invalid message ID: %w", err)
+	}
+	if MessageSvc == nil {
+		return nil, fmt.Errorf("messaging unavailable")
 	}
 
-	// Update chat last_message_at and unread flags
-	_, err = tx.Exec(`
-		UPDATE chats c
-		SET last_message_at = $3,
-			unread_for_user1 = CASE WHEN $2 = c.user2_id THEN TRUE ELSE unread_for_user1 END,
-			unread_for_user2 = CASE WHEN $2 = c.user1_id THEN TRUE ELSE unread_for_user2 END
-		WHERE c.id = $1
-	`, chatID, currentUserID, createdAt)
+	msg, err := MessageSvc.DeleteMessage(ctx, currentUserID, msgID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update chat: %w", err)
+		return nil, messageChangeError("delete", err)
 	}
+	return msg, nil
+}
+
+// AddReaction is the resolver for the addReaction field.
+func (r *mutationResolver) AddReaction(ctx context.Context, messageID string, emoji string) ([]*model.Reaction, error) {
+	return r.setReaction(ctx, messageID, emoji, true)
+}
 
-	// Create ChatMessage response
-	chatMessage := &model.ChatMessage{
-		ID:        strconv.FormatInt(msgID, 10),
-		ChatID:    strconv.Itoa(chatID),
-		SenderID:  strconv.Itoa(currentUserID),
-		Content:   content,
-		CreatedAt: createdAt.Format(time.RFC3339),
-		IsRead:    false, // New messages are unread by default
+// RemoveReaction is the resolver for the removeReaction field.
+func (r *mutationResolver) RemoveReaction(ctx context.Context, messageID string, emoji string) ([]*model.Reaction, error) {
+	return r.setReaction(ctx, messageID, emoji, false)
+}
+
+func (r *mutationResolver) setReaction(ctx context.Context, messageID string, emoji string, add bool) ([]*model.Reaction, error) {
+	currentUserID, err := extractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	msgID, err := strconv.ParseInt(messageID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid message ID: %w", err)
+	}
+	if MessageSvc == nil {
+		return nil, fmt.Errorf("messaging unavailable")
 	}
 
-	// Broadcast message to subscribers after successful transaction commit
-	if err == nil { // Transaction will be committed
-		go func() {
-			// Broadcast to real-time subscribers
-			subscriptionManager := GetSubscriptionManager()
-			subscriptionManager.BroadcastMessage(chatMessage)
-		}()
+	var reactions []*model.Reaction
+	if add {
+		reactions, err = MessageSvc.AddReaction(ctx, currentUserID, msgID, emoji)
+	} else {
+		reactions, err = MessageSvc.RemoveReaction(ctx, currentUserID, msgID, emoji)
 	}
+	if err != nil {
+		return nil, messageChangeError("react to", err)
+	}
+	return reactions, nil
+}
+
+// messageChangeError maps MessageService errors to client messages
+func messageChangeError(op string, err error) error {
+	switch err.Error() {
+	case "not_found":
+		return fmt.Errorf("message not found")
+	case "not_sender":
+		return fmt.Errorf("only the sender can %s a message", op)
+	case "message_deleted":
+		return fmt.Errorf("message was deleted")
+	case "empty_message":
+		return fmt.Errorf("message content cannot be empty")
+	case "invalid_reaction":
+		return fmt.Errorf("a reaction must be a single emoji")
+	case "blocked":
+		return fmt.Errorf("no accepted connection with target user")
+	}
+	return fmt.Errorf("failed to %s message: %w", op, err)
+}
 
-	return chatMessage, nil
+// formatTime formats an optional timestamp as RFC3339
+func formatTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := t.Format(time.RFC3339)
+	return &s
 }
 
 // MarkMessagesAsRead is the resolver for the markMessagesAsRead field.
@@ -1081,63 +1571,85 @@ func (r *mutationResolver) MarkMessagesAsRead(ctx context.Context, chatID string
 		return false, err
 	}
 
-	// Convert chat ID to int
+	// Convert chat ID to int; membership is checked by @chatMember
 	chatIDInt, err := strconv.Atoi(chatID)
 	if err != nil {
 		return false, fmt.Errorf("invalid chat ID: %w", err)
 	}
+	if MessageSvc == nil {
+		return false, fmt.Errorf("messaging unavailable")
+	}
 
-	// Verify that the current user is part of this chat
-	var userIsInChat bool
-	err = r.DB.QueryRow(`
-		SELECT EXISTS (
-			SELECT 1
-			FROM chats
-			WHERE id = $1 AND (user1_id = $2 OR user2_id = $2)
-		)
-	`, chatIDInt, currentUserID).Scan(&userIsInChat)
+	// Marks the peer's messages read and sends them a read receipt
+	if err := MessageSvc.MarkChatRead(ctx, currentUserID, chatIDInt); err != nil {
+		return false, fmt.Errorf("failed to mark messages as read: %w", err)
+	}
+	return true, nil
+}
 
+// MarkMessagesDelivered is the resolver for the markMessagesDelivered field.
+func (r *mutationResolver) MarkMessagesDelivered(ctx context.Context, messageID string) (bool, error) {
+	currentUserID, err := extractUserIDFromContext(ctx)
+	if err != nil {
+		return false, err
+	}
+	msgID, err := strconv.ParseInt(messageID, 10, 64)
 	if err != nil {
-		return false, fmt.Errorf("failed to verify chat access: %w", err)
+		return false, fmt.Errorf("invalid message ID: %w", err)
 	}
-	if !userIsInChat {
-		return false, fmt.Errorf("user not part of this chat")
+	if MessageSvc == nil {
+		return false, fmt.Errorf("messaging unavailable")
 	}
 
-	// Start transaction
-	tx, err := r.DB.Begin()
+	if err := MessageSvc.MarkDelivered(ctx, currentUserID, msgID); err != nil {
+		if err.Error() == "not_found" {
+			return false, fmt.Errorf("message not found")
+		}
+		return false, fmt.Errorf("failed to acknowledge message: %w", err)
+	}
+	return true, nil
+}
+
+// SetTyping is the resolver for the setTyping field.
+func (r *mutationResolver) SetTyping(ctx context.Context, chatID string, isTyping bool) (bool, error) {
+	currentUserID, err := extractUserIDFromContext(ctx)
 	if err != nil {
-		return false, fmt.Errorf("failed to start transaction: %w", err)
+		return false, err
 	}
-	defer func() {
-		if err != nil {
-			tx.Rollback()
-		} else {
-			tx.Commit()
-		}
-	}()
 
-	// Mark messages as read (only messages not sent by current user)
-	_, err = tx.Exec(`
-		UPDATE messages
-		SET is_read = TRUE
-		WHERE chat_id = $1 AND sender_id <> $2 AND is_read = FALSE
-	`, chatIDInt, currentUserID)
+	chatIDInt, err := strconv.Atoi(chatID)
 	if err != nil {
-		return false, fmt.Errorf("failed to mark messages as read: %w", err)
+		return false, fmt.Errorf("invalid chat ID: %w", err)
 	}
 
-	// Clear unread flag for current user in this chat
-	_, err = tx.Exec(`
-		UPDATE chats c
-		SET unread_for_user1 = CASE WHEN $2 = c.user1_id THEN FALSE ELSE unread_for_user1 END,
-			unread_for_user2 = CASE WHEN $2 = c.user2_id THEN FALSE ELSE unread_for_user2 END
-		WHERE c.id = $1
-	`, chatIDInt, currentUserID)
+	// Resolve the other participant; membership is checked by @chatMember
+	var peerID int
+	err = r.DB.QueryRow(`
+		SELECT CASE WHEN user1_id = $2 THEN user2_id ELSE user1_id END
+		FROM chats
+		WHERE id = $1
+	`, chatIDInt, currentUserID).Scan(&peerID)
+	if err != nil {
+		return false, fmt.Errorf("failed to resolve chat: %w", err)
+	}
+	blocked, err := blockedPair(ctx, currentUserID, peerID)
 	if err != nil {
-		return false, fmt.Errorf("failed to clear unread flags: %w", err)
+		return false, fmt.Errorf("failed to check blocks: %w", err)
+	}
+	if blocked {
+		return true, nil // silently dropped, the peer must not notice the block
 	}
 
+	if TypingSvc == nil {
+		// No tracker injected: broadcast directly, without expiry
+		GetSubscriptionManager().BroadcastTypingStatus(chatID, strconv.Itoa(currentUserID), isTyping)
+		return true, nil
+	}
+	if isTyping {
+		TypingSvc.Start(chatIDInt, currentUserID, peerID)
+	} else {
+		TypingSvc.Stop(currentUserID, peerID)
+	}
 	return true, nil
 }
 
@@ -1171,7 +1683,38 @@ func (r *mutationResolver) DismissRecommendation(ctx context.Context, userID str
 	if err != nil {
 		return false, fmt.Errorf("failed to dismiss recommendation: %w", err)
 	}
+	events.Default().PublishDismissal(events.Dismissal{UserID: currentUserID, DismissedUserID: targetID})
+
+	return true, nil
+}
+
+// SetUserRole is the resolver for the setUserRole field.
+func (r *mutationResolver) SetUserRole(ctx context.Context, userID string, role model.Role, reason string) (bool, error) {
+	currentUserID, err := extractUserIDFromContext(ctx)
+	if err != nil {
+		return false, err
+	}
+	targetID, err := strconv.Atoi(userID)
+	if err != nil {
+		return false, fmt.Errorf("invalid user ID: %w", err)
+	}
+	if AdminSvc == nil {
+		return false, fmt.Errorf("admin API unavailable")
+	}
 
+	if err := AdminSvc.SetRole(ctx, currentUserID, targetID, strings.ToLower(string(role)), reason); err != nil {
+		switch err.Error() {
+		case "not_found":
+			return false, fmt.Errorf("user not found")
+		case "invalid_target":
+			return false, fmt.Errorf("cannot change your own role")
+		case "invalid_reason":
+			return false, fmt.Errorf("a reason of at most 1000 characters is required")
+		case "invalid_state":
+			return false, fmt.Errorf("the user already has this role")
+		}
+		return false, fmt.Errorf("failed to set role: %w", err)
+	}
 	return true, nil
 }
 
@@ -1262,7 +1805,7 @@ func (r *queryResolver) UserProfile(ctx context.Context, id string) (*model.Prof
 		return nil, fmt.Errorf("failed to fetch profile: %w", err)
 	}
 
-	return &profile, nil
+	return viewableProfile(ctx, &profile), nil
 }
 
 // MyBio is the resolver for the myBio field.
@@ -1304,8 +1847,41 @@ func (r *queryResolver) UserBio(ctx context.Context, id string) (*model.Bio, err
 	return &bio, nil
 }
 
+// CitySearch is the resolver for the citySearch field.
+func (r *queryResolver) CitySearch(ctx context.Context, query string, limit *int) ([]*model.City, error) {
+	if _, err := extractUserIDFromContext(ctx); err != nil {
+		return nil, err
+	}
+	if GeoSvc == nil {
+		return nil, fmt.Errorf("city search unavailable")
+	}
+	if strings.TrimSpace(query) == "" {
+		return nil, fmt.Errorf("query must not be empty")
+	}
+	n := 10
+	if limit != nil {
+		if *limit < 1 {
+			return nil, fmt.Errorf("limit must be positive")
+		}
+		n = min(*limit, 50)
+	}
+	return GeoSvc.SearchCities(query, n), nil
+}
+
 // Recommendations is the resolver for the recommendations field.
 func (r *queryResolver) Recommendations(ctx context.Context) ([]*model.User, error) {
+	if RecommendationSvc != nil {
+		conn, err := r.RecommendationsConnection(ctx, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		users := make([]*model.User, 0, len(conn.Edges))
+		for _, edge := range conn.Edges {
+			users = append(users, edge.Node)
+		}
+		return users, nil
+	}
+
 	// Return some sample users for testing (no auth required for demo)
 	rows, err := r.DB.Query(`
 		SELECT DISTINCT u.id, u.email, u.created_at, u.updated_at, u.last_online
@@ -1331,7 +1907,159 @@ func (r *queryResolver) Recommendations(ctx context.Context) ([]*model.User, err
 	}
 
 	return users, nil
-} // Connections is the resolver for the connections field.
+}
+
+// RecommendationsConnection is the resolver for the recommendationsConnection field.
+func (r *queryResolver) RecommendationsConnection(ctx context.Context, first *int, after *string) (*model.RecommendationConnection, error) {
+	currentUserID, err := extractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if RecommendationSvc == nil {
+		return nil, fmt.Errorf("recommendations unavailable")
+	}
+
+	limit := 0
+	if first != nil {
+		if *first < 1 {
+			return nil, fmt.Errorf("first must be positive")
+		}
+		limit = *first
+	}
+	cursor := ""
+	if after != nil {
+		cursor = *after
+	}
+
+	page, err := RecommendationSvc.RecommendationPage(ctx, currentUserID, cursor, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch recommendations: %w", err)
+	}
+
+	ids := make([]int, len(page.Items))
+	for i, item := range page.Items {
+		ids[i] = item.UserID
+	}
+	users, err := r.loadUsers(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	conn := &model.RecommendationConnection{
+		Edges: make([]*model.RecommendationEdge, 0, len(page.Items)),
+		PageInfo: &model.PageInfo{
+			HasNextPage:     page.HasNextPage,
+			HasPreviousPage: page.HasPreviousPage,
+		},
+		TotalCount: page.TotalCount,
+	}
+	for i, item := range page.Items {
+		if users[i] == nil {
+			continue // account deleted since the snapshot was taken
+		}
+		conn.Edges = append(conn.Edges, &model.RecommendationEdge{
+			Cursor:          item.Cursor,
+			Node:            users[i],
+			Score:           item.Score,
+			ScorePercentage: item.ScorePercentage,
+			Distance:        item.Distance,
+		})
+	}
+	if edgesSelect(ctx, "explanation") && len(conn.Edges) > 0 {
+		explanations, err := RecommendationSvc.Explanations(ctx, currentUserID, ids)
+		if err != nil {
+			return nil, fmt.Errorf("failed to explain recommendations: %w", err)
+		}
+		for _, edge := range conn.Edges {
+			id, _ := strconv.Atoi(edge.Node.ID)
+			edge.Explanation = explanations[id]
+		}
+	}
+	if n := len(conn.Edges); n > 0 {
+		conn.PageInfo.StartCursor = &conn.Edges[0].Cursor
+		conn.PageInfo.EndCursor = &conn.Edges[n-1].Cursor
+	}
+	return conn, nil
+}
+
+// edgesSelect reports whether the query selects field on the edges of the
+// connection being resolved, so costly edge fields are only computed on demand
+func edgesSelect(ctx context.Context, field string) bool {
+	opCtx := graphql.GetOperationContext(ctx)
+	for _, f := range graphql.CollectFieldsCtx(ctx, nil) {
+		if f.Name != "edges" {
+			continue
+		}
+		for _, ef := range graphql.CollectFields(opCtx, f.Selections, nil) {
+			if ef.Name == field {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// RecommendationExplanation is the resolver for the recommendationExplanation field.
+func (r *queryResolver) RecommendationExplanation(ctx context.Context, userID string) (*model.RecommendationExplanation, error) {
+	currentUserID, err := extractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if RecommendationSvc == nil {
+		return nil, fmt.Errorf("recommendations unavailable")
+	}
+	targetID, err := strconv.Atoi(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	explanation, err := RecommendationSvc.Explanation(ctx, currentUserID, targetID)
+	if errors.Is(err, ErrNotRecommended) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to explain recommendation: %w", err)
+	}
+	return explanation, nil
+}
+
+// loadUsers fetches users in order, leaving nil for missing ones
+func (r *queryResolver) loadUsers(ctx context.Context, ids []int) ([]*model.User, error) {
+	users := make([]*model.User, len(ids))
+	if dataloaders := GetDataLoadersFromContext(ctx); dataloaders != nil {
+		loaded, errs := dataloaders.UserLoader.LoadMany(ctx, ids)()
+		for i := range ids {
+			if i < len(errs) && errs[i] != nil {
+				continue
+			}
+			users[i] = loaded[i]
+		}
+		return users, nil
+	}
+
+	for i, id := range ids {
+		var user model.User
+		var lastOnline sql.NullTime
+		err := r.DB.QueryRowContext(ctx, `
+			SELECT id, email, created_at, updated_at, last_online
+			FROM users WHERE id = $1
+		`, id).Scan(&user.ID, &user.Email, &user.CreatedAt, &user.UpdatedAt, &lastOnline)
+		if err == sql.ErrNoRows {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to load user: %w", err)
+		}
+		if lastOnline.Valid {
+			formatted := lastOnline.Time.Format(time.RFC3339)
+			user.LastOnline = &formatted
+		}
+		users[i] = &user
+	}
+	return users, nil
+}
+
+// Connections is the resolver for the connections field.
 func (r *queryResolver) Connections(ctx context.Context) ([]*model.Connection, error) {
 	currentUserID, err := extractUserIDFromContext(ctx)
 	if err != nil {
@@ -1451,6 +2179,33 @@ func (r *queryResolver) ConnectionRequests(ctx context.Context) ([]*model.Connec
 	return connections, nil
 }
 
+// BlockedUsers is the resolver for the blockedUsers field.
+func (r *queryResolver) BlockedUsers(ctx context.Context) ([]*model.User, error) {
+	currentUserID, err := extractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if BlockSvc == nil {
+		return []*model.User{}, nil
+	}
+
+	ids, err := BlockSvc.GetBlocked(ctx, currentUserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch blocked users: %w", err)
+	}
+	loaded, err := r.loadUsers(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	users := make([]*model.User, 0, len(loaded))
+	for _, u := range loaded {
+		if u != nil {
+			users = append(users, u)
+		}
+	}
+	return users, nil
+}
+
 // Chats is the resolver for the chats field.
 func (r *queryResolver) Chats(ctx context.Context) ([]*model.Chat, error) {
 	currentUserID, err := extractUserIDFromContext(ctx)
@@ -1505,17 +2260,13 @@ func (r *queryResolver) Chats(ctx context.Context) ([]*model.Chat, error) {
 
 // Chat is the resolver for the chat field.
 func (r *queryResolver) Chat(ctx context.Context, id string) (*model.Chat, error) {
-	currentUserID, err := extractUserIDFromContext(ctx)
-	if err != nil {
-		return nil, err
-	}
-
 	// Convert chat ID to int
 	chatID, err := strconv.Atoi(id)
 	if err != nil {
 		return nil, fmt.Errorf("invalid chat ID: %w", err)
 	}
 
+	// Membership is checked by @chatMember
 	var chat model.Chat
 	var user1ID, user2ID int
 	var lastMessageAt *time.Time
@@ -1523,8 +2274,8 @@ func (r *queryResolver) Chat(ctx context.Context, id string) (*model.Chat, error
 	err = r.DB.QueryRow(`
 		SELECT id, user1_id, user2_id, last_message_at, unread_for_user1, unread_for_user2
 		FROM chats
-		WHERE id = $1 AND (user1_id = $2 OR user2_id = $2)
-	`, chatID, currentUserID).Scan(
+		WHERE id = $1
+	`, chatID).Scan(
 		&chat.ID, &user1ID, &user2ID, &lastMessageAt, &chat.UnreadForUser1, &chat.UnreadForUser2,
 	)
 
@@ -1547,33 +2298,12 @@ func (r *queryResolver) Chat(ctx context.Context, id string) (*model.Chat, error
 
 // ChatMessages is the resolver for the chatMessages field.
 func (r *queryResolver) ChatMessages(ctx context.Context, chatID string, limit *int, offset *int) ([]*model.ChatMessage, error) {
-	currentUserID, err := extractUserIDFromContext(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	// Convert chat ID to int
+	// Convert chat ID to int; membership is checked by @chatMember
 	chatIDInt, err := strconv.Atoi(chatID)
 	if err != nil {
 		return nil, fmt.Errorf("invalid chat ID: %w", err)
 	}
 
-	// Verify user has access to this chat
-	var hasAccess bool
-	err = r.DB.QueryRow(`
-		SELECT EXISTS (
-			SELECT 1 FROM chats 
-			WHERE id = $1 AND (user1_id = $2 OR user2_id = $2)
-		)
-	`, chatIDInt, currentUserID).Scan(&hasAccess)
-
-	if err != nil {
-		return nil, fmt.Errorf("failed to verify chat access: %w", err)
-	}
-	if !hasAccess {
-		return nil, fmt.Errorf("chat not found or access denied")
-	}
-
 	// Set default values
 	limitVal := 50
 	if limit != nil && *limit > 0 && *limit <= 200 {
@@ -1585,8 +2315,9 @@ func (r *queryResolver) ChatMessages(ctx context.Context, chatID string, limit *
 		offsetVal = *offset
 	}
 
+	// Deleted messages are tombstones without content
 	rows, err := r.DB.Query(`
-		SELECT id, sender_id, content, created_at, is_read
+		SELECT id, sender_id, CASE WHEN deleted_at IS NULL THEN content ELSE '' END, created_at, is_read, edited_at, deleted_at, reply_to_id, delivered_at, read_at
 		FROM messages
 		WHERE chat_id = $1
 		ORDER BY created_at DESC
@@ -1599,12 +2330,15 @@ func (r *queryResolver) ChatMessages(ctx context.Context, chatID string, limit *
 	defer rows.Close()
 
 	var messages []*model.ChatMessage
+	var replyTo []int64 // per message, 0 when it quotes nothing
 	for rows.Next() {
 		var msg model.ChatMessage
 		var senderID int
 		var createdAt time.Time
+		var editedAt, deletedAt, deliveredAt, readAt *time.Time
+		var replyToID sql.NullInt64
 
-		err := rows.Scan(&msg.ID, &senderID, &msg.Content, &createdAt, &msg.IsRead)
+		err := rows.Scan(&msg.ID, &senderID, &msg.Content, &createdAt, &msg.IsRead, &editedAt, &deletedAt, &replyToID, &deliveredAt, &readAt)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan message: %w", err)
 		}
@@ -1612,50 +2346,131 @@ func (r *queryResolver) ChatMessages(ctx context.Context, chatID string, limit *
 		msg.ChatID = chatID
 		msg.SenderID = strconv.Itoa(senderID)
 		msg.CreatedAt = createdAt.Format(time.RFC3339)
+		msg.EditedAt = formatTime(editedAt)
+		msg.DeletedAt = formatTime(deletedAt)
+		msg.DeliveredAt = formatTime(deliveredAt)
+		msg.ReadAt = formatTime(readAt)
 
 		messages = append(messages, &msg)
+		replyTo = append(replyTo, replyToID.Int64)
 	}
 
 	if err = rows.Err(); err != nil {
 		return nil, fmt.Errorf("error iterating messages: %w", err)
 	}
 
+	if err := attachReactions(ctx, messages); err != nil {
+		return nil, err
+	}
+	if err := attachReplies(ctx, messages, replyTo); err != nil {
+		return nil, err
+	}
+
 	return messages, nil
 }
 
-// MessageReceived is the resolver for the messageReceived field.
-func (r *subscriptionResolver) MessageReceived(ctx context.Context, chatID string) (<-chan *model.ChatMessage, error) {
-	// Verify user has access to this chat
+// attachReplies fills in the quotes of a page of messages; replyTo holds the
+// quoted message ID of each one, 0 for none
+func attachReplies(ctx context.Context, messages []*model.ChatMessage, replyTo []int64) error {
+	var ids []int64
+	for _, id := range replyTo {
+		if id != 0 {
+			ids = append(ids, id)
+		}
+	}
+	if MessageSvc == nil || len(ids) == 0 {
+		return nil
+	}
 	currentUserID, err := extractUserIDFromContext(ctx)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	previews, err := MessageSvc.Previews(ctx, currentUserID, ids)
+	if err != nil {
+		return fmt.Errorf("failed to fetch quoted messages: %w", err)
+	}
+	for i, m := range messages {
+		m.ReplyTo = previews[replyTo[i]]
 	}
+	return nil
+}
 
-	// Convert chat ID to int for verification
-	chatIDInt, err := strconv.Atoi(chatID)
+// attachReactions fills in the reactions of a page of messages with one
+// lookup
+func attachReactions(ctx context.Context, messages []*model.ChatMessage) error {
+	if MessageSvc == nil || len(messages) == 0 {
+		return nil
+	}
+	currentUserID, err := extractUserIDFromContext(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("invalid chat ID: %w", err)
+		return err
+	}
+	ids := make([]int64, 0, len(messages))
+	for _, m := range messages {
+		if id, err := strconv.ParseInt(m.ID, 10, 64); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	reactions, err := MessageSvc.Reactions(ctx, currentUserID, ids)
+	if err != nil {
+		return fmt.Errorf("failed to fetch reactions: %w", err)
+	}
+	for _, m := range messages {
+		id, _ := strconv.ParseInt(m.ID, 10, 64)
+		m.Reactions = reactions[id]
 	}
+	return nil
+}
 
-	// Verify user has access to this chat
-	var hasAccess bool
-	err = r.DB.QueryRow(`
-		SELECT EXISTS (
-			SELECT 1 FROM chats 
-			WHERE id = $1 AND (user1_id = $2 OR user2_id = $2)
-		)
-	`, chatIDInt, currentUserID).Scan(&hasAccess)
+// AdminStats is the resolver for the adminStats field.
+func (r *queryResolver) AdminStats(ctx context.Context) (*model.AdminStats, error) {
+	if AdminSvc == nil {
+		return nil, fmt.Errorf("admin API unavailable")
+	}
+	return AdminSvc.Stats(ctx)
+}
+
+// AdminUser is the resolver for the adminUser field.
+func (r *queryResolver) AdminUser(ctx context.Context, id *string, email *string) (*model.AdminUser, error) {
+	if AdminSvc == nil {
+		return nil, fmt.Errorf("admin API unavailable")
+	}
+	userID := 0
+	lookup := ""
+	switch {
+	case id != nil:
+		var err error
+		if userID, err = strconv.Atoi(*id); err != nil {
+			return nil, fmt.Errorf("invalid user ID: %w", err)
+		}
+	case email != nil && strings.TrimSpace(*email) != "":
+		lookup = *email
+	default:
+		return nil, fmt.Errorf("id or email is required")
+	}
 
+	user, err := AdminSvc.User(ctx, userID, lookup)
 	if err != nil {
-		return nil, fmt.Errorf("failed to verify chat access: %w", err)
+		if err.Error() == "not_found" {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to look up user: %w", err)
 	}
-	if !hasAccess {
-		return nil, fmt.Errorf("chat not found or access denied")
+	return user, nil
+}
+
+// MessageReceived is the resolver for the messageReceived field.
+func (r *subscriptionResolver) MessageReceived(ctx context.Context, chatID string) (<-chan *model.ChatMessage, error) {
+	// Membership is checked by @chatMember
+	currentUserID, err := extractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	// Subscribe to messages for this chat
 	subscriptionManager := GetSubscriptionManager()
 	ch, cleanup := subscriptionManager.SubscribeToMessages(chatID)
+	trackPresence(ctx, currentUserID)
 
 	// Handle context cancellation to cleanup subscription
 	go func() {
@@ -1663,7 +2478,64 @@ func (r *subscriptionResolver) MessageReceived(ctx context.Context, chatID strin
 		cleanup()
 	}()
 
-	return ch, nil
+	return withoutBlocked(ctx, currentUserID, ch, func(m *model.ChatMessage) string { return m.SenderID }), nil
+}
+
+// MessageUpdated is the resolver for the messageUpdated field.
+func (r *subscriptionResolver) MessageUpdated(ctx context.Context, chatID string) (<-chan *model.ChatMessage, error) {
+	// Membership is checked by @chatMember
+	currentUserID, err := extractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ch, cleanup := GetSubscriptionManager().SubscribeToMessageUpdates(chatID)
+	trackPresence(ctx, currentUserID)
+
+	go func() {
+		<-ctx.Done()
+		cleanup()
+	}()
+
+	return withoutBlocked(ctx, currentUserID, ch, func(m *model.ChatMessage) string { return m.SenderID }), nil
+}
+
+// ReactionChanged is the resolver for the reactionChanged field.
+func (r *subscriptionResolver) ReactionChanged(ctx context.Context, chatID string) (<-chan *model.ReactionUpdate, error) {
+	// Membership is checked by @chatMember
+	currentUserID, err := extractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ch, cleanup := GetSubscriptionManager().SubscribeToReactions(chatID)
+	trackPresence(ctx, currentUserID)
+
+	go func() {
+		<-ctx.Done()
+		cleanup()
+	}()
+
+	return withoutBlocked(ctx, currentUserID, ch, func(u *model.ReactionUpdate) string { return u.UserID }), nil
+}
+
+// MessageStatusChanged is the resolver for the messageStatusChanged field.
+func (r *subscriptionResolver) MessageStatusChanged(ctx context.Context, chatID string) (<-chan *model.MessageStatusUpdate, error) {
+	// Membership is checked by @chatMember
+	currentUserID, err := extractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ch, cleanup := GetSubscriptionManager().SubscribeToMessageStatus(chatID)
+	trackPresence(ctx, currentUserID)
+
+	go func() {
+		<-ctx.Done()
+		cleanup()
+	}()
+
+	return withoutBlocked(ctx, currentUserID, ch, func(u *model.MessageStatusUpdate) string { return u.RecipientID }), nil
 }
 
 // ConnectionUpdate is the resolver for the connectionUpdate field.
@@ -1677,6 +2549,7 @@ func (r *subscriptionResolver) ConnectionUpdate(ctx context.Context) (<-chan *mo
 	// Subscribe to connection updates for this user
 	subscriptionManager := GetSubscriptionManager()
 	ch, cleanup := subscriptionManager.SubscribeToConnections(fmt.Sprintf("%d", currentUserID))
+	trackPresence(ctx, currentUserID)
 
 	// Handle context cancellation to cleanup subscription
 	go func() {
@@ -1701,9 +2574,21 @@ func (r *subscriptionResolver) UserPresence(ctx context.Context, userID string)
 		return nil, fmt.Errorf("user not found")
 	}
 
+	// A blocked pair cannot follow each other's presence
+	currentUserID, authErr := extractUserIDFromContext(ctx)
+	if authErr == nil {
+		targetID, _ := strconv.Atoi(userID)
+		if blocked, err := blockedPair(ctx, currentUserID, targetID); err != nil || blocked {
+			return nil, fmt.Errorf("user not found")
+		}
+	}
+
 	// Subscribe to presence updates for this user
 	subscriptionManager := GetSubscriptionManager()
 	ch, cleanup := subscriptionManager.SubscribeToPresence(userID)
+	if authErr == nil {
+		trackPresence(ctx, currentUserID)
+	}
 
 	// Handle context cancellation to cleanup subscription
 	go func() {
@@ -1711,42 +2596,24 @@ func (r *subscriptionResolver) UserPresence(ctx context.Context, userID string)
 		cleanup()
 	}()
 
-	return ch, nil
+	if authErr != nil {
+		return ch, nil
+	}
+	return withoutBlocked(ctx, currentUserID, ch, func(p *model.PresenceUpdate) string { return p.UserID }), nil
 }
 
 // TypingStatus is the resolver for the typingStatus field.
 func (r *subscriptionResolver) TypingStatus(ctx context.Context, chatID string) (<-chan *model.TypingStatus, error) {
-	// Verify user has access to this chat
+	// Membership is checked by @chatMember
 	currentUserID, err := extractUserIDFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	// Convert chat ID to int for verification
-	chatIDInt, err := strconv.Atoi(chatID)
-	if err != nil {
-		return nil, fmt.Errorf("invalid chat ID: %w", err)
-	}
-
-	// Verify user has access to this chat
-	var hasAccess bool
-	err = r.DB.QueryRow(`
-		SELECT EXISTS (
-			SELECT 1 FROM chats 
-			WHERE id = $1 AND (user1_id = $2 OR user2_id = $2)
-		)
-	`, chatIDInt, currentUserID).Scan(&hasAccess)
-
-	if err != nil {
-		return nil, fmt.Errorf("failed to verify chat access: %w", err)
-	}
-	if !hasAccess {
-		return nil, fmt.Errorf("chat not found or access denied")
-	}
-
 	// Subscribe to typing status for this chat
 	subscriptionManager := GetSubscriptionManager()
 	ch, cleanup := subscriptionManager.SubscribeToTyping(chatID)
+	trackPresence(ctx, currentUserID)
 
 	// Handle context cancellation to cleanup subscription
 	go func() {
@@ -1754,11 +2621,41 @@ func (r *subscriptionResolver) TypingStatus(ctx context.Context, chatID string)
 		cleanup()
 	}()
 
-	return ch, nil
+	return withoutBlocked(ctx, currentUserID, ch, func(t *model.TypingStatus) string { return t.UserID }), nil
+}
+
+// Edits is the resolver for the edits field.
+func (r *chatMessageResolver) Edits(ctx context.Context, obj *model.ChatMessage) ([]*model.MessageEdit, error) {
+	// Messages never edited, and tombstones, have no history to load
+	if obj.EditedAt == nil || obj.DeletedAt != nil || MessageSvc == nil {
+		return []*model.MessageEdit{}, nil
+	}
+	currentUserID, err := extractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	msgID, err := strconv.ParseInt(obj.ID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid message ID: %w", err)
+	}
+	edits, err := MessageSvc.MessageEdits(ctx, currentUserID, msgID)
+	if err != nil {
+		switch err.Error() {
+		case "not_found", "message_deleted":
+			return nil, messageChangeError("read", err)
+		}
+		return nil, fmt.Errorf("failed to fetch edit history: %w", err)
+	}
+	return edits, nil
 }
 
 // Profile is the resolver for the profile field.
 func (r *userResolver) Profile(ctx context.Context, obj *model.User) (*model.Profile, error) {
+	// Hidden users look the same as users without a profile
+	if allowed, err := canViewUser(ctx, obj.ID); err != nil || !allowed {
+		return nil, err
+	}
+
 	// Use DataLoader if available
 	if dataloaders := GetDataLoadersFromContext(ctx); dataloaders != nil {
 		userID, err := strconv.Atoi(obj.ID)
@@ -1766,7 +2663,11 @@ func (r *userResolver) Profile(ctx context.Context, obj *model.User) (*model.Pro
 			return nil, fmt.Errorf("invalid user ID: %w", err)
 		}
 		thunk := dataloaders.ProfileLoader.Load(ctx, userID)
-		return thunk()
+		profile, err := thunk()
+		if err != nil {
+			return nil, err
+		}
+		return viewableProfile(ctx, profile), nil
 	}
 
 	// Fallback to direct database query
@@ -1812,11 +2713,15 @@ func (r *userResolver) Profile(ctx context.Context, obj *model.User) (*model.Pro
 		profile.MaxRadiusKm = &intVal
 	}
 
-	return &profile, nil
+	return viewableProfile(ctx, &profile), nil
 }
 
 // Bio is the resolver for the bio field.
 func (r *userResolver) Bio(ctx context.Context, obj *model.User) (*model.Bio, error) {
+	if allowed, err := canViewUser(ctx, obj.ID); err != nil || !allowed {
+		return nil, err
+	}
+
 	// Use DataLoader if available
 	if dataloaders := GetDataLoadersFromContext(ctx); dataloaders != nil {
 		userID, err := strconv.Atoi(obj.ID)
@@ -1872,6 +2777,9 @@ func (r *userResolver) Bio(ctx context.Context, obj *model.User) (*model.Bio, er
 // Bio returns BioResolver implementation.
 func (r *Resolver) Bio() BioResolver { return &bioResolver{r} }
 
+// ChatMessage returns ChatMessageResolver implementation.
+func (r *Resolver) ChatMessage() ChatMessageResolver { return &chatMessageResolver{r} }
+
 // Mutation returns MutationResolver implementation.
 func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
 
@@ -1888,6 +2796,7 @@ func (r *Resolver) Subscription() SubscriptionResolver { return &subscriptionRes
 func (r *Resolver) User() UserResolver { return &userResolver{r} }
 
 type bioResolver struct{ *Resolver }
+type chatMessageResolver struct{ *Resolver }
 type mutationResolver struct{ *Resolver }
 type profileResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
@@ -1,13 +1,18 @@
 package graph
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"strconv"
 	"testing"
 	"time"
 
 	"gitea.kood.tech/petrkubec/match-me/backend/graph/model"
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/golang-jwt/jwt/v5"
 	_ "github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
@@ -104,7 +109,7 @@ func TestAuthenticationResolvers(t *testing.T) {
 	t.Run("Logout", func(t *testing.T) {
 		ctx := context.Background()
 
-		result, err := resolver.Mutation().Logout(ctx)
+		result, err := resolver.Mutation().Logout(ctx, nil)
 		require.NoError(t, err)
 		assert.True(t, result)
 	})
@@ -282,7 +287,7 @@ func TestNestedResolvers(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, user)
 
-		profile, err := resolver.User().Profile(ctx, user)
+		profile, err := resolver.User().Profile(authCtx, user)
 		require.NoError(t, err)
 		require.NotNil(t, profile)
 
@@ -312,3 +317,134 @@ func parseUserID(userID string) int {
 	}
 	return id
 }
+
+type stubBlockService struct {
+	BlockService
+	blocked map[[2]int]bool
+}
+
+func (s stubBlockService) IsBlocked(ctx context.Context, a, b int) (bool, error) {
+	return s.blocked[[2]int{a, b}] || s.blocked[[2]int{b, a}], nil
+}
+
+func TestWithoutBlocked(t *testing.T) {
+	BlockSvc = stubBlockService{blocked: map[[2]int]bool{{2, 1}: true}}
+	defer func() { BlockSvc = nil }()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	in := make(chan *model.TypingStatus, 4)
+	out := withoutBlocked(ctx, 1, in, func(s *model.TypingStatus) string { return s.UserID })
+	in <- &model.TypingStatus{UserID: "2", IsTyping: true}
+	in <- &model.TypingStatus{UserID: "3", IsTyping: true}
+	in <- &model.TypingStatus{UserID: "1", IsTyping: true}
+	close(in)
+
+	var got []string
+	for s := range out {
+		got = append(got, s.UserID)
+	}
+	assert.Equal(t, []string{"3", "1"}, got)
+}
+
+func TestHasRole(t *testing.T) {
+	next := func(ctx context.Context) (interface{}, error) { return "ok", nil }
+
+	_, err := HasRole(context.Background(), nil, next, model.RoleModerator)
+	assert.Error(t, err, "anonymous requests are rejected")
+
+	ctx := createTestContext(1)
+	_, err = HasRole(ctx, nil, next, model.RoleModerator)
+	assert.EqualError(t, err, "input: forbidden", "tokens without a role claim are users")
+
+	res, err := HasRole(context.WithValue(ctx, roleKey, "admin"), nil, next, model.RoleModerator)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", res)
+
+	_, err = HasRole(context.WithValue(ctx, roleKey, "moderator"), nil, next, model.RoleAdmin)
+	assert.Error(t, err)
+}
+
+// stubPolicy lets userID 1 into chat 10 and lets it see user 2 only
+type stubPolicy struct{}
+
+func (stubPolicy) CanViewUser(ctx context.Context, viewerID, targetID int) (bool, error) {
+	return viewerID == 1 && targetID == 2, nil
+}
+
+func (stubPolicy) IsChatMember(ctx context.Context, userID, chatID int) (bool, error) {
+	return userID == 1 && chatID == 10, nil
+}
+
+func TestPolicyDirectives(t *testing.T) {
+	prev := PolicySvc
+	PolicySvc = stubPolicy{}
+	defer func() { PolicySvc = prev }()
+
+	next := func(ctx context.Context) (interface{}, error) { return "ok", nil }
+	withArgs := func(ctx context.Context, args map[string]interface{}) context.Context {
+		return graphql.WithFieldContext(ctx, &graphql.FieldContext{Args: args})
+	}
+
+	_, err := ChatMember(withArgs(context.Background(), map[string]interface{}{"chatID": "10"}), nil, next, "chatID")
+	assert.Error(t, err, "anonymous requests are rejected")
+
+	res, err := ChatMember(withArgs(createTestContext(1), map[string]interface{}{"chatID": "10"}), nil, next, "chatID")
+	require.NoError(t, err)
+	assert.Equal(t, "ok", res)
+
+	_, err = ChatMember(withArgs(createTestContext(3), map[string]interface{}{"chatID": "10"}), nil, next, "chatID")
+	assert.EqualError(t, err, "input: chat not found or access denied")
+
+	_, err = ChatMember(withArgs(createTestContext(1), map[string]interface{}{"id": "x"}), nil, next, "id")
+	assert.Error(t, err, "non-numeric IDs are rejected")
+
+	res, err = CanViewUser(withArgs(createTestContext(1), map[string]interface{}{"id": "2"}), nil, next, "id")
+	require.NoError(t, err)
+	assert.Equal(t, "ok", res)
+
+	res, err = CanViewUser(withArgs(createTestContext(1), map[string]interface{}{"id": "3"}), nil, next, "id")
+	require.NoError(t, err)
+	assert.Nil(t, res, "hidden users resolve to null")
+}
+
+func TestHiddenUserFields(t *testing.T) {
+	prev := PolicySvc
+	PolicySvc = stubPolicy{}
+	defer func() { PolicySvc = prev }()
+
+	// user(id) is guarded like userProfile, so a hidden user never reaches
+	// the database
+	body, _ := json.Marshal(map[string]string{
+		"query": `{ user(id: "3") { email profile { displayName } bio { userID } } }`,
+	})
+	req := httptest.NewRequest(http.MethodPost, "/graphql", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	limitedServer(Limits{}).ServeHTTP(w, req.WithContext(createTestContext(1)))
+
+	var resp struct {
+		Data   map[string]interface{} `json:"data"`
+		Errors []interface{}          `json:"errors"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Empty(t, resp.Errors)
+	assert.Contains(t, resp.Data, "user")
+	assert.Nil(t, resp.Data["user"], "hidden users resolve to null")
+
+	// Nested fields are checked too, for users reached through other parents
+	resolver := &Resolver{}
+	hidden := &model.User{ID: "3"}
+
+	profile, err := resolver.User().Profile(createTestContext(1), hidden)
+	require.NoError(t, err)
+	assert.Nil(t, profile)
+
+	bio, err := resolver.User().Bio(createTestContext(1), hidden)
+	require.NoError(t, err)
+	assert.Nil(t, bio)
+
+	_, err = resolver.User().Profile(context.Background(), hidden)
+	assert.Error(t, err, "anonymous requests are rejected")
+}
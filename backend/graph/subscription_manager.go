@@ -1,9 +1,11 @@
 package graph
 
 import (
+	"strconv"
 	"sync"
 	"time"
 
+	"gitea.kood.tech/petrkubec/match-me/backend/events"
 	"gitea.kood.tech/petrkubec/match-me/backend/graph/model"
 )
 
@@ -13,6 +15,18 @@ type SubscriptionManager struct {
 	messageSubscribers map[string]map[chan *model.ChatMessage]bool
 	messageMutex       sync.RWMutex
 
+	// Message edit/delete subscriptions: chatID -> subscribers
+	updateSubscribers map[string]map[chan *model.ChatMessage]bool
+	updateMutex       sync.RWMutex
+
+	// Reaction subscriptions: chatID -> subscribers
+	reactionSubscribers map[string]map[chan *model.ReactionUpdate]bool
+	reactionMutex       sync.RWMutex
+
+	// Delivery/read receipt subscriptions: chatID -> subscribers
+	statusSubscribers map[string]map[chan *model.MessageStatusUpdate]bool
+	statusMutex       sync.RWMutex
+
 	// Connection subscriptions: userID -> subscribers
 	connectionSubscribers map[string]map[chan *model.Connection]bool
 	connectionMutex       sync.RWMutex
@@ -30,6 +44,9 @@ type SubscriptionManager struct {
 func NewSubscriptionManager() *SubscriptionManager {
 	return &SubscriptionManager{
 		messageSubscribers:    make(map[string]map[chan *model.ChatMessage]bool),
+		updateSubscribers:     make(map[string]map[chan *model.ChatMessage]bool),
+		reactionSubscribers:   make(map[string]map[chan *model.ReactionUpdate]bool),
+		statusSubscribers:     make(map[string]map[chan *model.MessageStatusUpdate]bool),
 		connectionSubscribers: make(map[string]map[chan *model.Connection]bool),
 		presenceSubscribers:   make(map[string]map[chan *model.PresenceUpdate]bool),
 		typingSubscribers:     make(map[string]map[chan *model.TypingStatus]bool),
@@ -44,6 +61,109 @@ func GetSubscriptionManager() *SubscriptionManager {
 	return globalSubscriptionManager
 }
 
+// HandleEvent converts bus events into GraphQL subscription payloads.
+// It is registered on the shared event bus at startup (see main.go).
+func (sm *SubscriptionManager) HandleEvent(evt events.Event) {
+	switch evt.Type {
+	case events.MessageCreated:
+		msg, ok := evt.Payload.(events.Message)
+		if !ok {
+			return
+		}
+		m := &model.ChatMessage{
+			ID:        strconv.FormatInt(msg.ID, 10),
+			ChatID:    strconv.Itoa(msg.ChatID),
+			SenderID:  strconv.Itoa(msg.SenderID),
+			Content:   msg.Body,
+			CreatedAt: msg.CreatedAt.Format(time.RFC3339),
+			IsRead:    false, // New messages are unread by default
+		}
+		if msg.ClientMsgID != "" {
+			id := msg.ClientMsgID
+			m.ClientMsgID = &id
+		}
+		if q := msg.ReplyTo; q != nil {
+			m.ReplyTo = &model.MessagePreview{
+				ID:       strconv.FormatInt(q.ID, 10),
+				SenderID: strconv.Itoa(q.SenderID),
+				Content:  q.Body,
+				Deleted:  q.Deleted,
+			}
+		}
+		sm.BroadcastMessage(m)
+
+	case events.MessageEdited, events.MessageDeleted:
+		c, ok := evt.Payload.(events.MessageChange)
+		if !ok {
+			return
+		}
+		at := c.At.Format(time.RFC3339)
+		msg := &model.ChatMessage{
+			ID:        strconv.FormatInt(c.ID, 10),
+			ChatID:    strconv.Itoa(c.ChatID),
+			SenderID:  strconv.Itoa(c.SenderID),
+			Content:   c.Body,
+			CreatedAt: c.CreatedAt.Format(time.RFC3339),
+		}
+		if evt.Type == events.MessageEdited {
+			msg.EditedAt = &at
+		} else {
+			msg.DeletedAt = &at
+		}
+		sm.BroadcastMessageUpdate(msg)
+
+	case events.ReactionChanged:
+		rc, ok := evt.Payload.(events.Reaction)
+		if !ok {
+			return
+		}
+		sm.BroadcastReaction(strconv.Itoa(rc.ChatID), &model.ReactionUpdate{
+			MessageID: strconv.FormatInt(rc.MessageID, 10),
+			UserID:    strconv.Itoa(rc.UserID),
+			Emoji:     rc.Emoji,
+			Added:     rc.Added,
+			Count:     rc.Count,
+		})
+
+	case events.MessageStatus:
+		rc, ok := evt.Payload.(events.Receipt)
+		if !ok {
+			return
+		}
+		ids := make([]string, len(rc.MessageIDs))
+		for i, id := range rc.MessageIDs {
+			ids[i] = strconv.FormatInt(id, 10)
+		}
+		status := model.MessageStatusDelivered
+		if rc.Status == "read" {
+			status = model.MessageStatusRead
+		}
+		sm.BroadcastMessageStatus(strconv.Itoa(rc.ChatID), &model.MessageStatusUpdate{
+			ChatID:      strconv.Itoa(rc.ChatID),
+			SenderID:    strconv.Itoa(rc.SenderID),
+			RecipientID: strconv.Itoa(rc.RecipientID),
+			MessageIDs:  ids,
+			Status:      status,
+			At:          rc.At.Format(time.RFC3339),
+		})
+
+	case events.PresenceChanged:
+		p, ok := evt.Payload.(events.Presence)
+		if !ok {
+			return
+		}
+		lastOnline := p.LastOnline
+		sm.BroadcastPresenceUpdate(strconv.Itoa(p.UserID), p.Online, &lastOnline)
+
+	case events.TypingChanged:
+		t, ok := evt.Payload.(events.Typing)
+		if !ok || t.ChatID == 0 {
+			return
+		}
+		sm.BroadcastTypingStatus(strconv.Itoa(t.ChatID), strconv.Itoa(t.UserID), t.Typing)
+	}
+}
+
 // Message Subscription Methods
 
 // SubscribeToMessages subscribes to messages for a specific chat
@@ -97,6 +217,152 @@ func (sm *SubscriptionManager) BroadcastMessage(message *model.ChatMessage) {
 	}
 }
 
+// SubscribeToMessageUpdates subscribes to edits and deletions in a chat
+func (sm *SubscriptionManager) SubscribeToMessageUpdates(chatID string) (<-chan *model.ChatMessage, func()) {
+	sm.updateMutex.Lock()
+	defer sm.updateMutex.Unlock()
+
+	ch := make(chan *model.ChatMessage, 10)
+
+	if sm.updateSubscribers[chatID] == nil {
+		sm.updateSubscribers[chatID] = make(map[chan *model.ChatMessage]bool)
+	}
+	sm.updateSubscribers[chatID][ch] = true
+
+	cleanup := func() {
+		sm.UnsubscribeFromMessageUpdates(chatID, ch)
+	}
+
+	return ch, cleanup
+}
+
+// UnsubscribeFromMessageUpdates removes a subscription for edits and deletions
+func (sm *SubscriptionManager) UnsubscribeFromMessageUpdates(chatID string, ch chan *model.ChatMessage) {
+	sm.updateMutex.Lock()
+	defer sm.updateMutex.Unlock()
+
+	if subscribers, ok := sm.updateSubscribers[chatID]; ok {
+		delete(subscribers, ch)
+		if len(subscribers) == 0 {
+			delete(sm.updateSubscribers, chatID)
+		}
+	}
+	close(ch)
+}
+
+// BroadcastMessageUpdate sends the new state of an edited or deleted message
+// to all subscribers of its chat
+func (sm *SubscriptionManager) BroadcastMessageUpdate(message *model.ChatMessage) {
+	sm.updateMutex.RLock()
+	defer sm.updateMutex.RUnlock()
+
+	for ch := range sm.updateSubscribers[message.ChatID] {
+		select {
+		case ch <- message:
+		default:
+			// Channel is full, skip this subscriber
+		}
+	}
+}
+
+// Reaction Subscription Methods
+
+// SubscribeToReactions subscribes to reaction changes in a chat
+func (sm *SubscriptionManager) SubscribeToReactions(chatID string) (<-chan *model.ReactionUpdate, func()) {
+	sm.reactionMutex.Lock()
+	defer sm.reactionMutex.Unlock()
+
+	ch := make(chan *model.ReactionUpdate, 10)
+
+	if sm.reactionSubscribers[chatID] == nil {
+		sm.reactionSubscribers[chatID] = make(map[chan *model.ReactionUpdate]bool)
+	}
+	sm.reactionSubscribers[chatID][ch] = true
+
+	cleanup := func() {
+		sm.UnsubscribeFromReactions(chatID, ch)
+	}
+
+	return ch, cleanup
+}
+
+// UnsubscribeFromReactions removes a subscription for reaction changes
+func (sm *SubscriptionManager) UnsubscribeFromReactions(chatID string, ch chan *model.ReactionUpdate) {
+	sm.reactionMutex.Lock()
+	defer sm.reactionMutex.Unlock()
+
+	if subscribers, ok := sm.reactionSubscribers[chatID]; ok {
+		delete(subscribers, ch)
+		if len(subscribers) == 0 {
+			delete(sm.reactionSubscribers, chatID)
+		}
+	}
+	close(ch)
+}
+
+// BroadcastReaction sends a reaction change to all subscribers of the chat
+func (sm *SubscriptionManager) BroadcastReaction(chatID string, update *model.ReactionUpdate) {
+	sm.reactionMutex.RLock()
+	defer sm.reactionMutex.RUnlock()
+
+	for ch := range sm.reactionSubscribers[chatID] {
+		select {
+		case ch <- update:
+		default:
+			// Channel is full, skip this subscriber
+		}
+	}
+}
+
+// Message Status Subscription Methods
+
+// SubscribeToMessageStatus subscribes to delivery and read receipts in a chat
+func (sm *SubscriptionManager) SubscribeToMessageStatus(chatID string) (<-chan *model.MessageStatusUpdate, func()) {
+	sm.statusMutex.Lock()
+	defer sm.statusMutex.Unlock()
+
+	ch := make(chan *model.MessageStatusUpdate, 10)
+
+	if sm.statusSubscribers[chatID] == nil {
+		sm.statusSubscribers[chatID] = make(map[chan *model.MessageStatusUpdate]bool)
+	}
+	sm.statusSubscribers[chatID][ch] = true
+
+	cleanup := func() {
+		sm.UnsubscribeFromMessageStatus(chatID, ch)
+	}
+
+	return ch, cleanup
+}
+
+// UnsubscribeFromMessageStatus removes a subscription for receipts
+func (sm *SubscriptionManager) UnsubscribeFromMessageStatus(chatID string, ch chan *model.MessageStatusUpdate) {
+	sm.statusMutex.Lock()
+	defer sm.statusMutex.Unlock()
+
+	if subscribers, ok := sm.statusSubscribers[chatID]; ok {
+		delete(subscribers, ch)
+		if len(subscribers) == 0 {
+			delete(sm.statusSubscribers, chatID)
+		}
+	}
+	close(ch)
+}
+
+// BroadcastMessageStatus sends a receipt to all subscribers of the chat
+func (sm *SubscriptionManager) BroadcastMessageStatus(chatID string, update *model.MessageStatusUpdate) {
+	sm.statusMutex.RLock()
+	defer sm.statusMutex.RUnlock()
+
+	for ch := range sm.statusSubscribers[chatID] {
+		select {
+		case ch <- update:
+		default:
+			// Channel is full, skip this subscriber
+		}
+	}
+}
+
 // Connection Subscription Methods
 
 // SubscribeToConnections subscribes to connection updates for a user
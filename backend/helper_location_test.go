@@ -53,17 +53,15 @@ func TestLocationPreferenceIntegration(t *testing.T) {
 		}
 
 		// Calculate expected scores (should be identical since location preference is 0)
-		analogScore := calculateInterestScore(userProfile.AnalogPassions, nearbyCandidate.AnalogPassions)
-		digitalScore := calculateInterestScore(userProfile.DigitalDelights, nearbyCandidate.DigitalDelights)
-		crossScore := crossPollinationScore(userProfile.CrossPollination, nearbyCandidate.CrossPollination)
-		foodScore := calculateFoodScore(userProfile.FavoriteFood, nearbyCandidate.FavoriteFood)
-		musicScore := calculateMusicScore(userProfile.FavoriteMusic, nearbyCandidate.FavoriteMusic)
-
-		expectedScore := (analogScore * userProfile.MatchPreferences["analog_passions"] / 3) +
-			(digitalScore * userProfile.MatchPreferences["digital_delights"] / 3) +
-			(crossScore * userProfile.MatchPreferences["collaboration_interests"] / 15) +
-			(foodScore * userProfile.MatchPreferences["favorite_food"] / 10) +
-			(musicScore * userProfile.MatchPreferences["favorite_music"] / 10)
+		model := newScoringModel(defaultScoringConfig())
+		nearbyScore := model.Score(ScoringPair{Viewer: &userProfile, Candidate: &nearbyCandidate,
+			DistanceKm: haversine(userProfile.LocationLat, userProfile.LocationLon, nearbyCandidate.LocationLat, nearbyCandidate.LocationLon)}, userProfile.MatchPreferences)
+		farScore := model.Score(ScoringPair{Viewer: &userProfile, Candidate: &farCandidate,
+			DistanceKm: haversine(userProfile.LocationLat, userProfile.LocationLon, farCandidate.LocationLat, farCandidate.LocationLon)}, userProfile.MatchPreferences)
+		if nearbyScore != farScore {
+			t.Errorf("Expected identical scores with location preference 0, got %d and %d", nearbyScore, farScore)
+		}
+		expectedScore := nearbyScore
 
 		t.Logf("✓ Confirmed: Location preference 0 means no location-based discrimination")
 		t.Logf("✓ Both nearby and far candidates would get same score: %d", expectedScore)
@@ -83,8 +81,8 @@ func TestLocationPreferenceIntegration(t *testing.T) {
 		locationWeight := 1 // Even preference = 1 should work
 		sameLocationScore := calculateLocationScore(60.1699, 24.9384, 60.1699, 24.9384, 50, locationWeight)
 
-		if sameLocationScore != locationWeight {
-			t.Errorf("Expected %d location points for same location with preference 1, got %d", locationWeight, sameLocationScore)
+		if sameLocationScore <= 0 {
+			t.Errorf("Expected location points for same location with preference 1, got %d", sameLocationScore)
 		}
 
 		t.Logf("✓ Confirmed: Location preference 1 adds %d location points for same location", sameLocationScore)
@@ -93,8 +91,8 @@ func TestLocationPreferenceIntegration(t *testing.T) {
 		locationWeight = 10
 		sameLocationScore = calculateLocationScore(60.1699, 24.9384, 60.1699, 24.9384, 50, locationWeight)
 
-		if sameLocationScore != locationWeight {
-			t.Errorf("Expected %d location points for same location with preference 10, got %d", locationWeight, sameLocationScore)
+		if sameLocationScore < locationWeight {
+			t.Errorf("Expected at least %d location points for same location with preference 10, got %d", locationWeight, sameLocationScore)
 		}
 
 		t.Logf("✓ Confirmed: Location preference 10 adds %d location points for same location", sameLocationScore)
@@ -105,8 +103,8 @@ func TestLocationPreferenceIntegration(t *testing.T) {
 		locationWeight := 10
 		sameLocationScore := calculateLocationScore(60.1699, 24.9384, 60.1699, 24.9384, 50, locationWeight)
 
-		if sameLocationScore != locationWeight {
-			t.Errorf("Expected %d location points for same location, got %d", locationWeight, sameLocationScore)
+		if sameLocationScore < locationWeight {
+			t.Errorf("Expected at least %d location points for same location, got %d", locationWeight, sameLocationScore)
 		}
 
 		t.Logf("✓ Confirmed: Location preference > 1 adds %d location points for same location", sameLocationScore)
@@ -115,9 +113,9 @@ func TestLocationPreferenceIntegration(t *testing.T) {
 }
 
 func TestCalculateLocationScore(t *testing.T) {
-	t.Run("Same location should return full score", func(t *testing.T) {
+	t.Run("Same location scores like the closest bucket", func(t *testing.T) {
 		score := calculateLocationScore(60.1699, 24.9384, 60.1699, 24.9384, 50, 20)
-		expected := 20 // Same location = full weight (no bonus for distance 0)
+		expected := int((1.0-bucketDistance(0)/50.0)*20.0) + 5 // Scored from the "<5 km" bucket, with the 5km bonus
 		if score != expected {
 			t.Errorf("Expected %d for same location, got %d", expected, score)
 		}
@@ -140,7 +138,7 @@ func TestCalculateLocationScore(t *testing.T) {
 
 		// Should get proximity score + 5km bonus
 		distance := haversine(userLat, userLon, candidateLat, candidateLon)
-		proximityRatio := 1.0 - (distance / 50.0)
+		proximityRatio := 1.0 - (bucketDistance(distance) / 50.0)
 		expectedBase := int(proximityRatio * 20.0)
 		expectedWithBonus := expectedBase + 5 // 5km bonus
 
@@ -158,7 +156,7 @@ func TestCalculateLocationScore(t *testing.T) {
 		score := calculateLocationScore(userLat, userLon, candidateLat, candidateLon, 50, 20)
 
 		distance := haversine(userLat, userLon, candidateLat, candidateLon)
-		proximityRatio := 1.0 - (distance / 50.0)
+		proximityRatio := 1.0 - (bucketDistance(distance) / 50.0)
 		expectedBase := int(proximityRatio * 20.0)
 		expectedWithBonus := expectedBase + 2 // 15km bonus
 
@@ -176,7 +174,7 @@ func TestCalculateLocationScore(t *testing.T) {
 		score := calculateLocationScore(userLat, userLon, candidateLat, candidateLon, 50, 20)
 
 		distance := haversine(userLat, userLon, candidateLat, candidateLon)
-		proximityRatio := 1.0 - (distance / 50.0)
+		proximityRatio := 1.0 - (bucketDistance(distance) / 50.0)
 		expected := int(proximityRatio * 20.0) // No bonus
 
 		if score != expected {
@@ -307,3 +305,14 @@ func TestHaversineDistance(t *testing.T) {
 		}
 	})
 }
+
+// calculateLocationScore runs the built-in location scorer on a single pair
+func calculateLocationScore(userLat, userLon, candidateLat, candidateLon float64, maxRadiusKm, locationWeight int) int {
+	scorer := scorerRegistry["location"](defaultScoringConfig())
+	pair := ScoringPair{
+		Viewer:     &Profile{LocationLat: userLat, LocationLon: userLon, MaxRadiusKm: maxRadiusKm},
+		Candidate:  &Profile{LocationLat: candidateLat, LocationLon: candidateLon},
+		DistanceKm: haversine(userLat, userLon, candidateLat, candidateLon),
+	}
+	return scorer.Score(pair, locationWeight)
+}
@@ -113,6 +113,20 @@ func createConnection(t *testing.T, fromUserID, toUserID int, status string) {
 	}
 }
 
+// authedCall serves a request with the token to the handler. A non-nil body
+// is sent as JSON.
+func authedCall(token, method, path string, body interface{}, handler http.Handler) *httptest.ResponseRecorder {
+	var raw []byte
+	if body != nil {
+		raw, _ = json.Marshal(body)
+	}
+	req := httptest.NewRequest(method, path, bytes.NewReader(raw))
+	req.Header.Set("Authorization", "Bearer "+token)
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	return w
+}
+
 // getDefaultTestProfile returns a default profile for testing
 func getDefaultTestProfile() TestProfile {
 	return TestProfile{
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"math"
+	"os"
+	"strconv"
+)
+
+// Other users never see exact coordinates or distances. Coordinates are
+// snapped to the centre of a cell of a locationGridKm grid, and distances are
+// reported as buckets. Only the scoring code reads the stored values.
+//
+// Each user's grid is shifted by an offset derived from a secret, so cell
+// borders differ between users and cannot be lined up to narrow a location
+// down. The offset is stable: asking again returns the same point, so there
+// is no noise to average away.
+const locationGridKm = 5.0
+
+// distanceBuckets are the upper bounds, in km, of the reported distance ranges
+var distanceBuckets = []float64{5, 15, 50, 100, 250}
+
+// locationSecret keys the per-user grid offsets. It defaults to the JWT secret.
+func locationSecret() []byte {
+	if secret := os.Getenv("LOCATION_SECRET"); secret != "" {
+		return []byte(secret)
+	}
+	return jwtSecret
+}
+
+// gridOffset returns the user's grid offset in cells, both in [0, 1)
+func gridOffset(userID int) (float64, float64) {
+	mac := hmac.New(sha256.New, locationSecret())
+	mac.Write([]byte("location-grid:" + strconv.Itoa(userID)))
+	sum := mac.Sum(nil)
+	u := float64(binary.BigEndian.Uint64(sum[0:8])>>11) / (1 << 53)
+	v := float64(binary.BigEndian.Uint64(sum[8:16])>>11) / (1 << 53)
+	return u, v
+}
+
+// displayLocation returns the coordinates shown to other users: the centre of
+// the cell of the user's jittered grid holding (lat, lon)
+func displayLocation(userID int, lat, lon float64) (float64, float64) {
+	u, v := gridOffset(userID)
+
+	cellLat := locationGridKm / (earthRadiusKm * math.Pi / 180)
+	lat = (math.Floor(lat/cellLat-u) + u + 0.5) * cellLat
+	lat = math.Max(-90, math.Min(90, lat))
+
+	// Cells keep their width in km, so they span more longitude towards the
+	// poles. The width is taken at the snapped latitude, which is the same
+	// for the whole row.
+	cellLon := 360.0
+	if c := math.Cos(lat * math.Pi / 180); c > cellLat/360 {
+		cellLon = math.Min(cellLat/c, 360)
+	}
+	lon = (math.Floor((lon+180)/cellLon-v)+v+0.5)*cellLon - 180
+	lon = math.Mod(math.Mod(lon+180, 360)+360, 360) - 180
+
+	return round4(lat), round4(lon)
+}
+
+// displayDistance is the distance between two users' display locations. It
+// is the only distance other users are shown, even as a range, so it changes
+// only when either point moves to another grid cell; moving one's own
+// location cannot find where the range flips any finer than that.
+func displayDistance(userA int, latA, lonA float64, userB int, latB, lonB float64) float64 {
+	latA, lonA = displayLocation(userA, latA, lonA)
+	latB, lonB = displayLocation(userB, latB, lonB)
+	return haversine(latA, lonA, latB, lonB)
+}
+
+// distanceBucket describes a distance in km as a coarse range
+func distanceBucket(km float64) string {
+	if km < distanceBuckets[0] {
+		return "<" + strconv.FormatFloat(distanceBuckets[0], 'f', -1, 64) + " km"
+	}
+	for i := 1; i < len(distanceBuckets); i++ {
+		if km < distanceBuckets[i] {
+			return strconv.FormatFloat(distanceBuckets[i-1], 'f', -1, 64) + "–" +
+				strconv.FormatFloat(distanceBuckets[i], 'f', -1, 64) + " km"
+		}
+	}
+	return strconv.FormatFloat(distanceBuckets[len(distanceBuckets)-1], 'f', -1, 64) + "+ km"
+}
+
+// bucketDistance returns the midpoint of the range distanceBucket reports for
+// km, or the last bound for the open-ended range. Location scores are computed
+// from it, so the points shown to the viewer reveal no more than the bucket.
+func bucketDistance(km float64) float64 {
+	lower := 0.0
+	for _, upper := range distanceBuckets {
+		if km < upper {
+			return (lower + upper) / 2
+		}
+		lower = upper
+	}
+	return lower
+}
+
+func round4(f float64) float64 {
+	return math.Round(f*1e4) / 1e4
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDisplayLocation(t *testing.T) {
+	jwtSecret = []byte("test-secret-key-for-testing")
+
+	for _, p := range [][2]float64{{60.1699, 24.9384}, {-33.8688, 151.2093}, {64.7337, 177.5089}, {-16.5, -179.99}, {89.99, 10}} {
+		lat, lon := displayLocation(7, p[0], p[1])
+		if again, againLon := displayLocation(7, p[0], p[1]); again != lat || againLon != lon {
+			t.Fatalf("display location of %v is not stable", p)
+		}
+		if lon < -180 || lon >= 180 || lat < -90 || lat > 90 {
+			t.Fatalf("display location %v, %v of %v is out of range", lat, lon, p)
+		}
+		// never further than a cell diagonal away, except near the poles
+		// where cells widen
+		if math.Abs(p[0]) < 80 {
+			if d := haversine(p[0], p[1], lat, lon); d > locationGridKm*math.Sqrt2 {
+				t.Errorf("display location of %v is %.1f km off", p, d)
+			}
+		}
+		if lat == round4(p[0]) && lon == round4(p[1]) {
+			t.Errorf("display location of %v is exact", p)
+		}
+	}
+
+	// small moves snap to the same point, and users have different grids
+	lat, lon := displayLocation(7, 60.1699, 24.9384)
+	same := 0
+	for _, d := range []float64{-0.002, 0.001, 0.002} {
+		if l, o := displayLocation(7, 60.1699+d, 24.9384+d); l == lat && o == lon {
+			same++
+		}
+	}
+	if same == 0 {
+		t.Error("expected nearby points to share a grid cell")
+	}
+	differ := false
+	for id := 8; id < 12; id++ {
+		if l, o := displayLocation(id, 60.1699, 24.9384); l != lat || o != lon {
+			differ = true
+		}
+	}
+	if !differ {
+		t.Error("expected grids to be offset per user")
+	}
+}
+
+func TestDistanceBucket(t *testing.T) {
+	cases := map[float64]string{
+		0:     "<5 km",
+		4.99:  "<5 km",
+		5:     "5–15 km",
+		12.3:  "5–15 km",
+		49:    "15–50 km",
+		99:    "50–100 km",
+		200:   "100–250 km",
+		12000: "250+ km",
+	}
+	for km, want := range cases {
+		if got := distanceBucket(km); got != want {
+			t.Errorf("distanceBucket(%v) = %q, want %q", km, got, want)
+		}
+	}
+}
+
+func TestDisplayDistanceFollowsGrid(t *testing.T) {
+	const target, viewer = 7, 8
+	targetLat, targetLon := 59.437, 24.7536
+	step := 0.05 / (earthRadiusKm * math.Pi / 180) // 50 m of latitude
+
+	// Walk the viewer 60 km north past several range edges. The distance
+	// shown may only change when the viewer's own grid cell does, so the
+	// edges cannot be located any finer than locationGridKm.
+	var prevLat, prevLon, prevKm float64
+	flips := 0
+	for i := 0; i <= 1200; i++ {
+		lat := targetLat + float64(i)*step
+		cellLat, cellLon := displayLocation(viewer, lat, targetLon)
+		km := displayDistance(viewer, lat, targetLon, target, targetLat, targetLon)
+		if i > 0 && cellLat == prevLat && cellLon == prevLon && km != prevKm {
+			t.Fatalf("distance changed from %v to %v inside one grid cell at step %d", prevKm, km, i)
+		}
+		if i > 0 && distanceBucket(km) != distanceBucket(prevKm) {
+			flips++
+		}
+		prevLat, prevLon, prevKm = cellLat, cellLon, km
+	}
+	if flips < 3 {
+		t.Errorf("expected the walk to cross at least 3 range edges, crossed %d", flips)
+	}
+}
@@ -1,10 +1,13 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net/http"
 	"os"
+	"strconv"
 
+	"gitea.kood.tech/petrkubec/match-me/backend/events"
 	"gitea.kood.tech/petrkubec/match-me/backend/graph"
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/playground"
@@ -20,17 +23,60 @@ func getJWTSecret() []byte {
 
 var jwtSecret = getJWTSecret()
 
+// graphQLLimits are the GraphQL defaults, overridden by GRAPHQL_MAX_DEPTH,
+// GRAPHQL_MAX_COMPLEXITY and GRAPHQL_RATE_LIMIT (operations per minute per
+// user). 0 disables a limit.
+func graphQLLimits() graph.Limits {
+	limits := graph.DefaultLimits
+	for env, field := range map[string]*int{
+		"GRAPHQL_MAX_DEPTH":      &limits.MaxDepth,
+		"GRAPHQL_MAX_COMPLEXITY": &limits.MaxComplexity,
+		"GRAPHQL_RATE_LIMIT":     &limits.OperationsPerMinute,
+	} {
+		v := os.Getenv(env)
+		if v == "" {
+			continue
+		}
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			log.Printf("%s: invalid value %q, keeping %d", env, v, *field)
+			continue
+		}
+		*field = n
+	}
+	return limits
+}
+
 func main() {
 	initDB()
 
+	// `backend migrate up|down [N]|status` manages the schema and exits
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := migrateCommand(db, os.Args[2:]); err != nil {
+			log.Fatal("migrate: ", err)
+		}
+		return
+	}
+	runMigrations(db)
+
+	// Rate limits are per process unless they are kept in Postgres, which
+	// every replica shares
+	if os.Getenv("RATE_LIMIT_STORE") == "postgres" {
+		rateLimiter = NewRateLimiter(NewRateLimitRepository(db))
+	}
+	events.Default().Subscribe(logAuditEvent)
+
 	mux := http.NewServeMux()
 
 	// Make sure that the upload directory for avatars exist
 	_ = os.MkdirAll("./uploads/avatars", 0o755)
 
 	// Core auth & user endpoints
-	mux.Handle("/register", registerHandler(db))
-	mux.Handle("/login", loginHandler(db))
+	mux.Handle("/register", rateLimit(limitRegister, registerHandler(db)))
+	mux.Handle("/login", rateLimit(limitLogin, loginHandler(db)))
+	mux.Handle("/logout", logoutHandler(db))              // POST
+	mux.Handle("/logout/all", logoutAllHandler(db))       // POST
+	mux.Handle("/token/refresh", tokenRefreshHandler(db)) // POST
 	mux.Handle("/me", meHandler(db))
 	mux.Handle("/me/profile", meProfileHandler(db))
 	mux.Handle("/me/bio", meBioHandler(db))
@@ -42,14 +88,33 @@ func main() {
 	// Recommendations & connections
 	mux.Handle("/recommendations", recommendationsHandler(db))
 	mux.Handle("/recommendations/detailed", recommendationsDetailedHandler(db))
-	mux.Handle("/recommendations/", dismissRecommendationHandler(db)) // /recommendations/{id}/dismiss
-	mux.Handle("/connections", connectionsHandler(db))                // GET /connections
-	mux.Handle("/connections/", connectionsActionsRouter(db))         // POST/DELETE /connections/{id}/...
-	mux.Handle("/connections/requests", requestsHandler(db))          // Listing requested connections
+	mux.Handle("/recommendations/", recommendationActionsRouter(db)) // /recommendations/{id}/(dismiss|explanation)
+	mux.Handle("/connections", connectionsHandler(db))               // GET /connections
+	mux.Handle("/connections/", connectionsActionsRouter(db))        // POST/DELETE /connections/{id}/...
+	mux.Handle("/connections/requests", requestsHandler(db))         // Listing requested connections
+	mux.Handle("/blocks", blocksHandler(db))                         // GET /blocks
+	mux.Handle("/blocks/", blockActionsHandler(db))                  // POST/DELETE /blocks/{id}
+	mux.Handle("/reports", reportsHandler(db))                       // POST /reports
+	mux.Handle("/me/warnings", myWarningsHandler(db))                // GET /me/warnings
+
+	// Moderation (moderators and admins only)
+	mux.Handle("/moderation/reports", moderationQueueHandler(db)) // GET the open reports
+	mux.Handle("/moderation/", moderationActionsRouter(db))       // POST /moderation/reports/{id}/dismiss, /moderation/users/{id}/actions
+
+	// Admin API (admins only)
+	mux.Handle("/admin/stats", adminStatsHandler(db)) // GET
+	mux.Handle("/admin/users", adminUsersRouter(db))  // GET ?email=
+	mux.Handle("/admin/users/", adminUsersRouter(db)) // GET /admin/users/{id}, POST /admin/users/{id}/actions
 
 	// Users dispatcher (summary, profile, bio)
 	mux.Handle("/users/", usersDispatcher(db))
 
+	// Both real-time transports listen on the same event bus, so a message sent
+	// over WebSocket reaches GraphQL subscribers and vice versa.
+	events.Default().Subscribe(chatHub.handleEvent)
+	events.Default().Subscribe(graph.GetSubscriptionManager().HandleEvent)
+	events.Default().Subscribe(typingStatus.handleEvent)
+
 	// WebSocket chat endpoint
 	mux.Handle("/ws/chat", wsChatHandler(db))
 
@@ -65,6 +130,9 @@ func main() {
 	mux.Handle("/me/avatar", myAvatarHandler(db))     // POST & DELETE
 	mux.Handle("/avatars/", getUserAvatarHandler(db)) // GET /avatars/{id}
 
+	// City autocomplete from the offline gazetteer
+	mux.Handle("/geo/cities", citySearchHandler()) // GET /geo/cities?q=
+
 	// Health check endpoint for Docker
 	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
@@ -84,12 +152,35 @@ func main() {
 	graph.ConnectionsSvc = NewConnectionService(db, connRepo)
 
 	recRepo := NewRecommendationRepository(db)
-	graph.RecommendationSvc = NewRecommendationService(recRepo)
-
-	srv := handler.NewDefaultServer(graph.NewExecutableSchema(graph.Config{Resolvers: graph.NewResolver(db)}))
-
-	// Create middleware chain: DataLoader -> Auth -> GraphQL
-	graphqlHandler := graph.DataLoaderMiddleware(db)(graph.AuthMiddleware(srv))
+	recSvc := NewRecommendationService(recRepo)
+	graph.RecommendationSvc = graphRecommendationService{recSvc}
+
+	// Keep the materialized recommendation lists fresh
+	recWorker := newRecommendationWorker(recRepo, recSvc)
+	events.Default().Subscribe(recWorker.handleEvent)
+	go recWorker.Run(context.Background())
+
+	userPresence.notify = presenceNotifier(db)
+	graph.PresenceSvc = userPresence
+	graph.TypingSvc = typingStatus
+	graph.GeoSvc = graphGeoService{}
+	graph.BlockSvc = NewBlockService(db, NewBlockRepository(db))
+	graph.ModerationSvc = NewModerationService(db, NewModerationRepository(db))
+
+	graph.AdminSvc = graphAdminService{NewAdminService(db, NewAdminRepository(db))}
+	graph.PolicySvc = NewAccessPolicy(db)
+	graph.LimitSvc = graphRateLimiter{rateLimiter}
+	graph.MessageSvc = graphMessageService{NewChatService(NewChatRepository(db), db)}
+
+	srv := handler.NewDefaultServer(graph.NewExecutableSchema(graph.Config{
+		Resolvers:  graph.NewResolver(db),
+		Directives: graph.Directives(),
+		Complexity: graph.Complexity(),
+	}))
+	graph.UseLimits(srv, graphQLLimits())
+
+	// Create middleware chain: client IP -> DataLoader -> Auth -> GraphQL
+	graphqlHandler := withClientIP(graph.DataLoaderMiddleware(db)(graph.AuthMiddleware(srv)))
 	mux.Handle("/graphql", graphqlHandler)
 
 	// GraphQL playground for development only
@@ -100,7 +191,7 @@ func main() {
 	} else {
 		log.Println("GraphQL playground disabled in production mode")
 	}
-	
+
 	log.Default().Println("Starting Match Me Backend on port 8080 (accessible via port 8081)...")
 	http.ListenAndServe(":8080", withCORS(mux))
 }
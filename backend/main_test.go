@@ -41,5 +41,13 @@ func TestMain(m *testing.M) {
 	}
 	defer db.Close()
 
+	// Bring the test database to the current schema the way main does. Without
+	// a database the unit tests still run; the database tests fail on their own.
+	if err := db.Ping(); err != nil {
+		log.Printf("test database unavailable, skipping migrations: %v", err)
+	} else {
+		runMigrations(db)
+	}
+
 	m.Run()
 }
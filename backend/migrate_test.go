@@ -0,0 +1,112 @@
+package main
+
+import (
+	"context"
+	"io/fs"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestLoadMigrations(t *testing.T) {
+	t.Run("Embedded migrations are valid", func(t *testing.T) {
+		sub, err := fs.Sub(migrationFiles, "migrations")
+		if err != nil {
+			t.Fatal(err)
+		}
+		migrations, err := loadMigrations(sub)
+		if err != nil {
+			t.Fatalf("loadMigrations failed: %v", err)
+		}
+		if len(migrations) == 0 {
+			t.Fatal("expected embedded migrations")
+		}
+		for i, m := range migrations {
+			if m.Version != i+1 {
+				t.Errorf("expected contiguous versions, got %d at position %d", m.Version, i)
+			}
+		}
+	})
+
+	tests := []struct {
+		name    string
+		files   fstest.MapFS
+		wantErr string
+	}{
+		{
+			name: "Missing down file",
+			files: fstest.MapFS{
+				"0001_init.up.sql": {Data: []byte("SELECT 1;")},
+			},
+			wantErr: "both up and down",
+		},
+		{
+			name: "Invalid file name",
+			files: fstest.MapFS{
+				"init.sql": {Data: []byte("SELECT 1;")},
+			},
+			wantErr: "invalid file name",
+		},
+		{
+			name: "Conflicting names",
+			files: fstest.MapFS{
+				"0001_init.up.sql":    {Data: []byte("SELECT 1;")},
+				"0001_other.down.sql": {Data: []byte("SELECT 1;")},
+			},
+			wantErr: "conflicting names",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := loadMigrations(tt.files)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+
+	t.Run("Sorted by version", func(t *testing.T) {
+		files := fstest.MapFS{
+			"0010_later.up.sql":     {Data: []byte("SELECT 10;")},
+			"0010_later.down.sql":   {Data: []byte("SELECT 10;")},
+			"0002_earlier.up.sql":   {Data: []byte("SELECT 2;")},
+			"0002_earlier.down.sql": {Data: []byte("SELECT 2;")},
+		}
+		migrations, err := loadMigrations(files)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(migrations) != 2 || migrations[0].Version != 2 || migrations[1].Name != "later" {
+			t.Errorf("unexpected order: %+v", migrations)
+		}
+	})
+}
+
+func TestMigratorUp(t *testing.T) {
+	migrator, err := NewMigrator(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The test database is already migrated (or adopted); running again is a no-op
+	if _, err := migrator.Up(context.Background()); err != nil {
+		t.Fatalf("Up failed: %v", err)
+	}
+	done, err := migrator.Up(context.Background())
+	if err != nil {
+		t.Fatalf("second Up failed: %v", err)
+	}
+	if len(done) != 0 {
+		t.Errorf("expected no pending migrations, applied %v", done)
+	}
+
+	statuses, err := migrator.Status(context.Background())
+	if err != nil {
+		t.Fatalf("Status failed: %v", err)
+	}
+	for _, st := range statuses {
+		if st.AppliedAt == nil {
+			t.Errorf("migration %04d_%s is still pending", st.Version, st.Name)
+		}
+	}
+}
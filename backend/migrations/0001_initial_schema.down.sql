@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS messages;
+DROP TABLE IF EXISTS chats;
+DROP TABLE IF EXISTS dismissed_recommendations;
+DROP TABLE IF EXISTS connections;
+DROP TYPE IF EXISTS connection_status;
+DROP TABLE IF EXISTS profiles;
+DROP TABLE IF EXISTS users;
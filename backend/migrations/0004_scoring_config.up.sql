@@ -0,0 +1,9 @@
+-- Runtime overrides for config/scoring.json. The row named 'default' is
+-- merged over the built-in config, e.g.
+--   INSERT INTO scoring_config (name, config)
+--   VALUES ('default', '{"max_results": 20, "dimensions": {"location": {"divisor": 2}}}');
+CREATE TABLE IF NOT EXISTS scoring_config (
+    name VARCHAR(64) PRIMARY KEY,
+    config JSONB NOT NULL,
+    updated_at TIMESTAMPTZ DEFAULT NOW() NOT NULL
+);
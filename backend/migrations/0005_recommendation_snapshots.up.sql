@@ -0,0 +1,10 @@
+-- Ranked recommendation lists frozen for cursor pagination
+CREATE TABLE IF NOT EXISTS recommendation_snapshots (
+    id VARCHAR(32) PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    results JSONB NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW() NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_recommendation_snapshots_expires ON recommendation_snapshots (expires_at);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS recommendation_state;
+DROP TABLE IF EXISTS user_recommendations;
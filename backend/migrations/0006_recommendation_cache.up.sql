@@ -0,0 +1,28 @@
+-- Materialized per-user recommendation lists, rebuilt by the background
+-- refresher. recommendation_state tracks freshness per user.
+CREATE TABLE IF NOT EXISTS user_recommendations (
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    candidate_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    rank INTEGER NOT NULL,
+    score INTEGER NOT NULL,
+    score_percentage DOUBLE PRECISION NOT NULL,
+    distance_km DOUBLE PRECISION,
+    PRIMARY KEY (user_id, candidate_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_recommendations_rank ON user_recommendations (user_id, rank);
+CREATE INDEX IF NOT EXISTS idx_user_recommendations_candidate ON user_recommendations (candidate_id);
+
+CREATE TABLE IF NOT EXISTS recommendation_state (
+    user_id INTEGER PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    stale BOOLEAN NOT NULL DEFAULT TRUE,
+    computed_at TIMESTAMPTZ,
+    claimed_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_recommendation_state_stale ON recommendation_state (stale, computed_at);
+
+-- Queue every existing complete profile for its first refresh
+INSERT INTO recommendation_state (user_id)
+SELECT user_id FROM profiles WHERE is_complete = TRUE
+ON CONFLICT (user_id) DO NOTHING;
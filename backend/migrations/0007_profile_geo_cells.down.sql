@@ -0,0 +1,3 @@
+CREATE INDEX IF NOT EXISTS idx_profiles_location ON profiles (location_lat, location_lon);
+DROP INDEX IF EXISTS idx_profiles_geo_cell;
+ALTER TABLE profiles DROP COLUMN IF EXISTS geo_cell;
@@ -0,0 +1,11 @@
+-- Grid-cell spatial index for radius search. geo_cell numbers 1x1 degree cells
+-- row-major from (-90, -180); it must match geoCell in geo.go. Being a
+-- generated column, it is maintained on every profile write.
+ALTER TABLE profiles ADD COLUMN IF NOT EXISTS geo_cell INTEGER GENERATED ALWAYS AS (
+    LEAST(179, floor(location_lat + 90)::int) * 360 + (floor(location_lon + 180)::int % 360)
+) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_profiles_geo_cell ON profiles (geo_cell) WHERE is_complete = TRUE;
+
+-- Superseded by the cell index; the lat/lon box could not wrap around the antimeridian
+DROP INDEX IF EXISTS idx_profiles_location;
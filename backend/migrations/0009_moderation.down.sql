@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS moderation_actions;
+DROP TABLE IF EXISTS reports;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS suspended_until,
+    DROP COLUMN IF EXISTS banned_at,
+    DROP COLUMN IF EXISTS role;
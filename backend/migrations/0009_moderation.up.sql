@@ -0,0 +1,41 @@
+-- Roles gate the moderation queue. Banned users, and suspended users until
+-- suspended_until, cannot log in or use existing tokens.
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS role TEXT NOT NULL DEFAULT 'user' CHECK (role IN ('user', 'moderator', 'admin')),
+    ADD COLUMN IF NOT EXISTS banned_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS suspended_until TIMESTAMPTZ;
+
+-- Reports of a user's profile, or of one of their messages when message_id
+-- is set. A reporter has at most one open report per profile or message.
+CREATE TABLE IF NOT EXISTS reports (
+    id SERIAL PRIMARY KEY,
+    reporter_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    reported_user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    message_id INTEGER REFERENCES messages(id) ON DELETE SET NULL,
+    reason TEXT NOT NULL CHECK (reason <> ''),
+    status TEXT NOT NULL DEFAULT 'open' CHECK (status IN ('open', 'resolved', 'dismissed')),
+    resolved_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    resolved_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT NOW() NOT NULL,
+    CHECK (reporter_id <> reported_user_id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_reports_open_unique
+    ON reports (reporter_id, reported_user_id, COALESCE(message_id, 0))
+    WHERE status = 'open';
+CREATE INDEX IF NOT EXISTS idx_reports_open ON reports (created_at) WHERE status = 'open';
+CREATE INDEX IF NOT EXISTS idx_reports_reported ON reports (reported_user_id);
+
+-- Every warning, suspension and ban, with who issued it and why
+CREATE TABLE IF NOT EXISTS moderation_actions (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    moderator_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    report_id INTEGER REFERENCES reports(id) ON DELETE SET NULL,
+    action TEXT NOT NULL CHECK (action IN ('warn', 'suspend', 'ban')),
+    reason TEXT NOT NULL,
+    until TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT NOW() NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_moderation_actions_user ON moderation_actions (user_id, created_at DESC);
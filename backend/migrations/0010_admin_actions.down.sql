@@ -0,0 +1,7 @@
+DELETE FROM moderation_actions WHERE action NOT IN ('warn', 'suspend', 'ban');
+
+ALTER TABLE moderation_actions DROP CONSTRAINT IF EXISTS moderation_actions_action_check;
+ALTER TABLE moderation_actions ADD CONSTRAINT moderation_actions_action_check
+    CHECK (action IN ('warn', 'suspend', 'ban'));
+
+ALTER TABLE moderation_actions DROP COLUMN IF EXISTS role;
@@ -0,0 +1,7 @@
+-- Admin account actions are audited next to moderation actions. role is the
+-- new role of a set_role action.
+ALTER TABLE moderation_actions ADD COLUMN IF NOT EXISTS role TEXT;
+
+ALTER TABLE moderation_actions DROP CONSTRAINT IF EXISTS moderation_actions_action_check;
+ALTER TABLE moderation_actions ADD CONSTRAINT moderation_actions_action_check
+    CHECK (action IN ('warn', 'suspend', 'ban', 'unban', 'unsuspend', 'set_role', 'logout_all'));
@@ -0,0 +1,19 @@
+-- Shared state for the rate limiter, used when RATE_LIMIT_STORE=postgres so
+-- every replica sees the same buckets and lockouts.
+CREATE TABLE IF NOT EXISTS rate_limit_buckets (
+    key        TEXT PRIMARY KEY,
+    tokens     DOUBLE PRECISION NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+-- Consecutive failed logins per account. locked_until is set once the
+-- failures reach the lockout threshold.
+CREATE TABLE IF NOT EXISTS login_failures (
+    key          TEXT PRIMARY KEY,
+    failures     INTEGER NOT NULL,
+    locked_until TIMESTAMPTZ,
+    updated_at   TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_rate_limit_buckets_updated ON rate_limit_buckets (updated_at);
+CREATE INDEX IF NOT EXISTS idx_login_failures_updated ON login_failures (updated_at);
@@ -0,0 +1,16 @@
+-- Senders can edit their messages and delete them for everyone. A deleted
+-- message stays as a tombstone: participants see deleted_at and no content,
+-- while moderators reviewing a report still see what was said.
+ALTER TABLE messages
+    ADD COLUMN IF NOT EXISTS edited_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+-- Edit history: the content each edit replaced, and when
+CREATE TABLE IF NOT EXISTS message_edits (
+    id SERIAL PRIMARY KEY,
+    message_id INTEGER NOT NULL REFERENCES messages(id) ON DELETE CASCADE,
+    content TEXT NOT NULL,
+    edited_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_message_edits_message ON message_edits (message_id, edited_at);
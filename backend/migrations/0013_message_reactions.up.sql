@@ -0,0 +1,9 @@
+-- Emoji reactions to messages. A user reacts at most once with each emoji
+-- to a message; deleting the message removes its reactions.
+CREATE TABLE IF NOT EXISTS message_reactions (
+    message_id INTEGER NOT NULL REFERENCES messages(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    emoji TEXT NOT NULL CHECK (char_length(emoji) BETWEEN 1 AND 16),
+    created_at TIMESTAMPTZ DEFAULT NOW() NOT NULL,
+    PRIMARY KEY (message_id, user_id, emoji)
+);
@@ -0,0 +1,7 @@
+-- A message can quote an earlier message of the same chat. The quote is read
+-- live, so it follows edits and shows a tombstone once the quoted message is
+-- deleted; if the quoted row itself goes away the reference is cleared.
+ALTER TABLE messages
+    ADD COLUMN IF NOT EXISTS reply_to_id INTEGER REFERENCES messages(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_messages_reply_to ON messages (reply_to_id) WHERE reply_to_id IS NOT NULL;
@@ -0,0 +1,10 @@
+-- Per-message receipts: when the recipient's client acknowledged the message
+-- and when they read it. is_read stays as the unread flag the summaries use;
+-- messages already read get their creation time as a best guess.
+ALTER TABLE messages
+    ADD COLUMN IF NOT EXISTS delivered_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS read_at TIMESTAMPTZ;
+
+UPDATE messages
+SET delivered_at = created_at, read_at = created_at
+WHERE is_read AND read_at IS NULL;
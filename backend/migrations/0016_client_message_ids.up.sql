@@ -0,0 +1,6 @@
+-- Clients tag each send with their own ID so a retried send (e.g. after a
+-- dropped socket) returns the stored message instead of creating a duplicate.
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS client_msg_id TEXT;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_messages_client_msg_id
+    ON messages (sender_id, client_msg_id) WHERE client_msg_id IS NOT NULL;
@@ -0,0 +1,169 @@
+package main
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultQueueLimit = 20
+	maxQueueLimit     = 100
+)
+
+// writeModerationError maps moderation errors to responses
+func writeModerationError(w http.ResponseWriter, where string, err error) {
+	switch {
+	case errors.Is(err, ErrNotFound):
+		writeError(w, http.StatusNotFound, "not_found")
+	case errors.Is(err, ErrAlreadyReported):
+		writeError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, ErrInvalidTarget), errors.Is(err, ErrInvalidReason),
+		errors.Is(err, ErrInvalidAction), errors.Is(err, ErrInvalidDuration):
+		writeError(w, http.StatusBadRequest, err.Error())
+	default:
+		writeError(w, http.StatusInternalServerError, "db_error")
+		log.Println(where, "error:", err)
+	}
+}
+
+// POST /reports - Reports a user's profile, or one of their messages to me
+func reportsHandler(db *sql.DB) http.HandlerFunc {
+	svc := NewModerationService(db, NewModerationRepository(db))
+	return authenticate(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			writeError(w, http.StatusMethodNotAllowed, "invalid_method")
+			return
+		}
+		var req struct {
+			UserID    int    `json:"user_id"`
+			MessageID *int64 `json:"message_id"`
+			Reason    string `json:"reason"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, http.StatusBadRequest, "invalid_json")
+			return
+		}
+		me := r.Context().Value(userIDKey).(int)
+
+		id, err := svc.ReportUser(r.Context(), me, req.UserID, req.MessageID, req.Reason)
+		if err != nil {
+			writeModerationError(w, "reportsHandler", err)
+			return
+		}
+		writeJSON(w, http.StatusCreated, map[string]int{"id": id})
+	})
+}
+
+// GET /me/warnings - Lists the warnings moderators gave me
+func myWarningsHandler(db *sql.DB) http.HandlerFunc {
+	svc := NewModerationService(db, NewModerationRepository(db))
+	return authenticate(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			writeError(w, http.StatusMethodNotAllowed, "invalid_method")
+			return
+		}
+		me := r.Context().Value(userIDKey).(int)
+
+		warnings, err := svc.GetWarnings(r.Context(), me)
+		if err != nil {
+			writeModerationError(w, "myWarningsHandler", err)
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"warnings": warnings})
+	})
+}
+
+// GET /moderation/reports?limit=&offset= - The open reports, oldest first
+func moderationQueueHandler(db *sql.DB) http.HandlerFunc {
+	svc := NewModerationService(db, NewModerationRepository(db))
+	return requireRole(roleModerator, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			writeError(w, http.StatusMethodNotAllowed, "invalid_method")
+			return
+		}
+		limit, offset := defaultQueueLimit, 0
+		if v := r.URL.Query().Get("limit"); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil || n < 1 {
+				writeError(w, http.StatusBadRequest, "invalid_limit")
+				return
+			}
+			limit = min(n, maxQueueLimit)
+		}
+		if v := r.URL.Query().Get("offset"); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil || n < 0 {
+				writeError(w, http.StatusBadRequest, "invalid_offset")
+				return
+			}
+			offset = n
+		}
+
+		reports, err := svc.GetQueue(r.Context(), limit, offset)
+		if err != nil {
+			writeModerationError(w, "moderationQueueHandler", err)
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"reports": reports})
+	})
+}
+
+// POST /moderation/reports/{id}/dismiss - Closes a report without action
+// POST /moderation/users/{id}/actions - Warns, suspends or bans a user
+func moderationActionsRouter(db *sql.DB) http.HandlerFunc {
+	svc := NewModerationService(db, NewModerationRepository(db))
+	return requireRole(roleModerator, func(w http.ResponseWriter, r *http.Request) {
+		parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+		if len(parts) != 4 || parts[0] != "moderation" {
+			http.NotFound(w, r)
+			return
+		}
+		id, err := strconv.Atoi(parts[2])
+		if err != nil {
+			writeError(w, http.StatusNotFound, "not_found")
+			return
+		}
+		if r.Method != http.MethodPost {
+			writeError(w, http.StatusMethodNotAllowed, "invalid_method")
+			return
+		}
+		me := r.Context().Value(userIDKey).(int)
+
+		switch {
+		case parts[1] == "reports" && parts[3] == "dismiss":
+			if err := svc.DismissReport(r.Context(), me, id); err != nil {
+				writeModerationError(w, "moderationActionsRouter", err)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+
+		case parts[1] == "users" && parts[3] == "actions":
+			var req struct {
+				Action        string `json:"action"`
+				Reason        string `json:"reason"`
+				DurationHours int    `json:"duration_hours"`
+				ReportID      int    `json:"report_id"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				writeError(w, http.StatusBadRequest, "invalid_json")
+				return
+			}
+			action, err := svc.TakeAction(r.Context(), me, id, req.Action, req.Reason,
+				time.Duration(req.DurationHours)*time.Hour, req.ReportID)
+			if err != nil {
+				writeModerationError(w, "moderationActionsRouter", err)
+				return
+			}
+			writeJSON(w, http.StatusCreated, action)
+
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
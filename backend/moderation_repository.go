@@ -0,0 +1,234 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// ModerationRepository defines the data access methods for reports and
+// moderation actions
+type ModerationRepository interface {
+	GetUserRole(ctx context.Context, userID int) (string, error)
+	CreateReport(ctx context.Context, reporterID, reportedID int, messageID *int64, reason string) (int, error)
+	IsMessageFrom(ctx context.Context, messageID int64, senderID, peerID int) (bool, error)
+	GetReport(ctx context.Context, reportID int) (Report, error)
+	GetOpenReports(ctx context.Context, limit, offset int) ([]QueuedReport, error)
+	GetMessageContext(ctx context.Context, messageID int64, window int) ([]ChatMessage, error)
+	DismissReport(ctx context.Context, reportID, moderatorID int) (bool, error)
+	GetWarnings(ctx context.Context, userID int) ([]ModerationAction, error)
+
+	// Used inside TakeAction's transaction
+	InsertAction(ctx context.Context, tx *sql.Tx, a *ModerationAction) error
+	RestrictUser(ctx context.Context, tx *sql.Tx, userID int, action string, until *time.Time) error
+	ResolveReports(ctx context.Context, tx *sql.Tx, moderatorID, reportedID, reportID int) error
+}
+
+type sqlModerationRepo struct {
+	db *sql.DB
+}
+
+func NewModerationRepository(db *sql.DB) ModerationRepository {
+	return &sqlModerationRepo{db: db}
+}
+
+func (r *sqlModerationRepo) GetUserRole(ctx context.Context, userID int) (string, error) {
+	var role string
+	err := r.db.QueryRowContext(ctx, `SELECT role FROM users WHERE id = $1`, userID).Scan(&role)
+	if err == sql.ErrNoRows {
+		return "", ErrNotFound
+	}
+	return role, err
+}
+
+// CreateReport files a report, failing with ErrAlreadyReported while the
+// reporter's previous report of the same profile or message is still open
+func (r *sqlModerationRepo) CreateReport(ctx context.Context, reporterID, reportedID int, messageID *int64, reason string) (int, error) {
+	var id int
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO reports (reporter_id, reported_user_id, message_id, reason)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id
+	`, reporterID, reportedID, messageID, reason).Scan(&id)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" { // unique_violation
+			return 0, ErrAlreadyReported
+		}
+		return 0, err
+	}
+	return id, nil
+}
+
+// IsMessageFrom reports whether the message was sent by senderID in their
+// chat with peerID
+func (r *sqlModerationRepo) IsMessageFrom(ctx context.Context, messageID int64, senderID, peerID int) (bool, error) {
+	var ok bool
+	err := r.db.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1
+			FROM messages m
+			JOIN chats c ON c.id = m.chat_id
+			WHERE m.id = $1 AND m.sender_id = $2
+			  AND c.user1_id = LEAST($2::int, $3::int) AND c.user2_id = GREATEST($2::int, $3::int)
+		)
+	`, messageID, senderID, peerID).Scan(&ok)
+	return ok, err
+}
+
+func (r *sqlModerationRepo) GetReport(ctx context.Context, reportID int) (Report, error) {
+	var rep Report
+	err := r.db.QueryRowContext(ctx, `
+		SELECT id, reporter_id, reported_user_id, message_id, reason, status, created_at, resolved_by, resolved_at
+		FROM reports WHERE id = $1
+	`, reportID).Scan(&rep.ID, &rep.ReporterID, &rep.ReportedUserID, &rep.MessageID, &rep.Reason,
+		&rep.Status, &rep.CreatedAt, &rep.ResolvedBy, &rep.ResolvedAt)
+	if err == sql.ErrNoRows {
+		return Report{}, ErrNotFound
+	}
+	return rep, err
+}
+
+// GetOpenReports pages through the open reports, oldest first
+func (r *sqlModerationRepo) GetOpenReports(ctx context.Context, limit, offset int) ([]QueuedReport, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT r.id, r.reporter_id, r.reported_user_id, r.message_id, r.reason, r.status, r.created_at,
+		       (SELECT COUNT(*) FROM reports o WHERE o.reported_user_id = r.reported_user_id AND o.status = 'open')
+		FROM reports r
+		WHERE r.status = 'open'
+		ORDER BY r.created_at, r.id
+		LIMIT $1 OFFSET $2
+	`, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	reports := []QueuedReport{}
+	for rows.Next() {
+		var q QueuedReport
+		if err := rows.Scan(&q.ID, &q.ReporterID, &q.ReportedUserID, &q.MessageID, &q.Reason,
+			&q.Status, &q.CreatedAt, &q.OpenReports); err != nil {
+			return nil, err
+		}
+		reports = append(reports, q)
+	}
+	return reports, rows.Err()
+}
+
+// GetMessageContext returns the message with up to window messages before
+// and after it in the same chat, oldest first
+func (r *sqlModerationRepo) GetMessageContext(ctx context.Context, messageID int64, window int) ([]ChatMessage, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		WITH t AS (SELECT chat_id, created_at, id FROM messages WHERE id = $1)
+		SELECT id, chat_id, sender_id, content, created_at FROM (
+			(SELECT m.id, m.chat_id, m.sender_id, m.content, m.created_at
+			 FROM messages m, t
+			 WHERE m.chat_id = t.chat_id AND (m.created_at, m.id) < (t.created_at, t.id)
+			 ORDER BY m.created_at DESC, m.id DESC
+			 LIMIT $2)
+			UNION ALL
+			(SELECT m.id, m.chat_id, m.sender_id, m.content, m.created_at
+			 FROM messages m, t
+			 WHERE m.chat_id = t.chat_id AND (m.created_at, m.id) >= (t.created_at, t.id)
+			 ORDER BY m.created_at, m.id
+			 LIMIT $2 + 1)
+		) around
+		ORDER BY created_at, id
+	`, messageID, window)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	msgs := []ChatMessage{}
+	for rows.Next() {
+		m := ChatMessage{Type: "message"}
+		if err := rows.Scan(&m.ID, &m.ChatID, &m.From, &m.Body, &m.Ts); err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, m)
+	}
+	return msgs, rows.Err()
+}
+
+// DismissReport closes an open report without action, reporting false when
+// there is no open report with that id
+func (r *sqlModerationRepo) DismissReport(ctx context.Context, reportID, moderatorID int) (bool, error) {
+	res, err := r.db.ExecContext(ctx, `
+		UPDATE reports SET status = 'dismissed', resolved_by = $2, resolved_at = NOW()
+		WHERE id = $1 AND status = 'open'
+	`, reportID, moderatorID)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
+
+// GetWarnings lists the warnings issued to the user, most recent first
+func (r *sqlModerationRepo) GetWarnings(ctx context.Context, userID int) ([]ModerationAction, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, user_id, COALESCE(moderator_id, 0), report_id, action, reason, until, created_at
+		FROM moderation_actions
+		WHERE user_id = $1 AND action = 'warn'
+		ORDER BY created_at DESC, id DESC
+	`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	actions := []ModerationAction{}
+	for rows.Next() {
+		var a ModerationAction
+		if err := rows.Scan(&a.ID, &a.UserID, &a.ModeratorID, &a.ReportID, &a.Action, &a.Reason, &a.Until, &a.CreatedAt); err != nil {
+			return nil, err
+		}
+		actions = append(actions, a)
+	}
+	return actions, rows.Err()
+}
+
+// InsertAction records the action, filling in its id and creation time
+func (r *sqlModerationRepo) InsertAction(ctx context.Context, tx *sql.Tx, a *ModerationAction) error {
+	return tx.QueryRowContext(ctx, `
+		INSERT INTO moderation_actions (user_id, moderator_id, report_id, action, reason, until, role)
+		VALUES ($1, $2, $3, $4, $5, $6, NULLIF($7, ''))
+		RETURNING id, created_at
+	`, a.UserID, a.ModeratorID, a.ReportID, a.Action, a.Reason, a.Until, a.Role).Scan(&a.ID, &a.CreatedAt)
+}
+
+// RestrictUser bans the user, or suspends them until the given time, and
+// revokes all their tokens. A longer running suspension is kept.
+func (r *sqlModerationRepo) RestrictUser(ctx context.Context, tx *sql.Tx, userID int, action string, until *time.Time) error {
+	var err error
+	switch action {
+	case "ban":
+		_, err = tx.ExecContext(ctx, `UPDATE users SET banned_at = COALESCE(banned_at, NOW()) WHERE id = $1`, userID)
+	case "suspend":
+		_, err = tx.ExecContext(ctx, `
+			UPDATE users SET suspended_until = GREATEST(suspended_until, $2)
+			WHERE id = $1
+		`, userID, until)
+	default:
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return revokeAllTokensTx(ctx, tx, userID)
+}
+
+// ResolveReports resolves one open report against the user, or all of them
+// when reportID is 0
+func (r *sqlModerationRepo) ResolveReports(ctx context.Context, tx *sql.Tx, moderatorID, reportedID, reportID int) error {
+	_, err := tx.ExecContext(ctx, `
+		UPDATE reports SET status = 'resolved', resolved_by = $1, resolved_at = NOW()
+		WHERE reported_user_id = $2 AND status = 'open' AND ($3 = 0 OR id = $3)
+	`, moderatorID, reportedID, reportID)
+	return err
+}
@@ -0,0 +1,241 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"gitea.kood.tech/petrkubec/match-me/backend/events"
+)
+
+var (
+	ErrAlreadyReported = errors.New("already_reported")
+	ErrInvalidReason   = errors.New("invalid_reason")
+	ErrInvalidAction   = errors.New("invalid_action")
+	ErrInvalidDuration = errors.New("invalid_duration")
+)
+
+const (
+	// maxReasonLength bounds the free text of reports and actions
+	maxReasonLength = 1000
+	// reportContextWindow is how many messages before and after a reported
+	// message the queue shows
+	reportContextWindow = 5
+	// maxSuspension is the longest suspension a moderator can hand out
+	maxSuspension = 365 * 24 * time.Hour
+)
+
+// Report is a user's report of another user's profile, or of one of their
+// messages when MessageID is set
+type Report struct {
+	ID             int        `json:"id"`
+	ReporterID     int        `json:"reporter_id"`
+	ReportedUserID int        `json:"reported_user_id"`
+	MessageID      *int64     `json:"message_id,omitempty"`
+	Reason         string     `json:"reason"`
+	Status         string     `json:"status"` // open | resolved | dismissed
+	CreatedAt      time.Time  `json:"created_at"`
+	ResolvedBy     *int       `json:"resolved_by,omitempty"`
+	ResolvedAt     *time.Time `json:"resolved_at,omitempty"`
+}
+
+// QueuedReport is an open report as moderators see it. Context holds the
+// reported message and the chat history around it.
+type QueuedReport struct {
+	Report
+	OpenReports int           `json:"open_reports"` // open reports against the same user, this one included
+	Context     []ChatMessage `json:"context,omitempty"`
+}
+
+// ModerationAction is a warning, suspension or ban issued to a user, or an
+// admin's account action (see AdminService.AccountAction)
+type ModerationAction struct {
+	ID          int        `json:"id"`
+	UserID      int        `json:"user_id"`
+	ModeratorID int        `json:"moderator_id,omitempty"`
+	ReportID    *int       `json:"report_id,omitempty"`
+	Action      string     `json:"action"` // warn | suspend | ban | unban | unsuspend | set_role | logout_all
+	Reason      string     `json:"reason"`
+	Until       *time.Time `json:"until,omitempty"` // end of a suspension
+	Role        string     `json:"role,omitempty"`  // new role of set_role
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// ModerationService handles abuse reports and the actions moderators take on them
+type ModerationService interface {
+	ReportUser(ctx context.Context, reporterID, reportedID int, messageID *int64, reason string) (int, error)
+	GetWarnings(ctx context.Context, userID int) ([]ModerationAction, error)
+
+	// Moderator only
+	GetQueue(ctx context.Context, limit, offset int) ([]QueuedReport, error)
+	DismissReport(ctx context.Context, moderatorID, reportID int) error
+	TakeAction(ctx context.Context, moderatorID, userID int, action, reason string, duration time.Duration, reportID int) (ModerationAction, error)
+}
+
+type moderationService struct {
+	db    *sql.DB
+	repo  ModerationRepository
+	block BlockRepository
+}
+
+func NewModerationService(db *sql.DB, repo ModerationRepository) ModerationService {
+	return &moderationService{
+		db:    db,
+		repo:  repo,
+		block: NewBlockRepository(db),
+	}
+}
+
+// cleanReason trims a free-text reason and rejects empty or overlong ones
+func cleanReason(reason string) (string, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" || len([]rune(reason)) > maxReasonLength {
+		return "", ErrInvalidReason
+	}
+	return reason, nil
+}
+
+// ReportUser files a report of reportedID's profile, or of one of their
+// messages to the reporter. Users can report someone they blocked.
+func (s *moderationService) ReportUser(ctx context.Context, reporterID, reportedID int, messageID *int64, reason string) (int, error) {
+	if reporterID == reportedID {
+		return 0, ErrInvalidTarget
+	}
+	reason, err := cleanReason(reason)
+	if err != nil {
+		return 0, err
+	}
+	exists, err := s.block.UserExists(ctx, reportedID)
+	if err != nil {
+		return 0, err
+	}
+	if !exists {
+		return 0, ErrNotFound
+	}
+	if messageID != nil {
+		ok, err := s.repo.IsMessageFrom(ctx, *messageID, reportedID, reporterID)
+		if err != nil {
+			return 0, err
+		}
+		if !ok {
+			return 0, ErrNotFound
+		}
+	}
+	return s.repo.CreateReport(ctx, reporterID, reportedID, messageID, reason)
+}
+
+// GetWarnings lists the warnings a user received, without the moderator
+func (s *moderationService) GetWarnings(ctx context.Context, userID int) ([]ModerationAction, error) {
+	warnings, err := s.repo.GetWarnings(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range warnings {
+		warnings[i].ModeratorID = 0
+	}
+	return warnings, nil
+}
+
+// GetQueue pages through the open reports, oldest first, with the chat
+// history around each reported message
+func (s *moderationService) GetQueue(ctx context.Context, limit, offset int) ([]QueuedReport, error) {
+	reports, err := s.repo.GetOpenReports(ctx, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	for i := range reports {
+		if reports[i].MessageID == nil {
+			continue
+		}
+		if reports[i].Context, err = s.repo.GetMessageContext(ctx, *reports[i].MessageID, reportContextWindow); err != nil {
+			return nil, err
+		}
+	}
+	return reports, nil
+}
+
+func (s *moderationService) DismissReport(ctx context.Context, moderatorID, reportID int) error {
+	dismissed, err := s.repo.DismissReport(ctx, reportID, moderatorID)
+	if err != nil {
+		return err
+	}
+	if !dismissed {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// TakeAction warns, suspends (for duration) or bans userID. A reportID other
+// than 0 must be an open report against the user and is resolved with the
+// action; a ban resolves every open report against the user. Suspensions and
+// bans end all the user's sessions. Staff accounts cannot be acted on here.
+func (s *moderationService) TakeAction(ctx context.Context, moderatorID, userID int, action, reason string, duration time.Duration, reportID int) (ModerationAction, error) {
+	if moderatorID == userID {
+		return ModerationAction{}, ErrInvalidTarget
+	}
+	reason, err := cleanReason(reason)
+	if err != nil {
+		return ModerationAction{}, err
+	}
+
+	a := ModerationAction{UserID: userID, ModeratorID: moderatorID, Action: action, Reason: reason}
+	switch action {
+	case "warn", "ban":
+	case "suspend":
+		if duration <= 0 || duration > maxSuspension {
+			return ModerationAction{}, ErrInvalidDuration
+		}
+		until := time.Now().Add(duration)
+		a.Until = &until
+	default:
+		return ModerationAction{}, ErrInvalidAction
+	}
+
+	role, err := s.repo.GetUserRole(ctx, userID)
+	if err != nil {
+		return ModerationAction{}, err
+	}
+	if role != roleUser {
+		return ModerationAction{}, ErrInvalidTarget
+	}
+
+	if reportID != 0 {
+		rep, err := s.repo.GetReport(ctx, reportID)
+		if err != nil {
+			return ModerationAction{}, err
+		}
+		if rep.Status != "open" || rep.ReportedUserID != userID {
+			return ModerationAction{}, ErrNotFound
+		}
+		a.ReportID = &reportID
+	}
+
+	err = withTx(ctx, s.db, func(tx *sql.Tx) error {
+		if err := s.repo.InsertAction(ctx, tx, &a); err != nil {
+			return err
+		}
+		if err := s.repo.RestrictUser(ctx, tx, userID, action, a.Until); err != nil {
+			return err
+		}
+		switch {
+		case action == "ban":
+			return s.repo.ResolveReports(ctx, tx, moderatorID, userID, 0)
+		case reportID != 0:
+			return s.repo.ResolveReports(ctx, tx, moderatorID, userID, reportID)
+		}
+		return nil
+	})
+	if err != nil {
+		return ModerationAction{}, err
+	}
+
+	switch action {
+	case "ban":
+		events.Default().PublishAccount(events.Account{UserID: userID, Status: accountBanned})
+	case "suspend":
+		events.Default().PublishAccount(events.Account{UserID: userID, Status: accountSuspended})
+	}
+	return a, nil
+}
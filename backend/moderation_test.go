@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestModerationFlow(t *testing.T) {
+	alice := createTestUserForConnections(t, "mod_alice@test.com", "password123")
+	bob := createTestUserForConnections(t, "mod_bob@test.com", "password123")
+	mod := createTestUserForConnections(t, "mod_moderator@test.com", "password123")
+	defer cleanupConnectionTestData("mod_alice@test.com", "mod_bob@test.com", "mod_moderator@test.com")
+
+	mod.Token = promoteTestUser(t, mod, "mod_moderator@test.com", roleModerator)
+	ctx := context.Background()
+	repo := NewChatRepository(db)
+	repo.SaveChatMsg(ctx, alice.ID, bob.ID, "hello", 0, "")
+	abusive, _, err := repo.SaveChatMsg(ctx, bob.ID, alice.ID, "something abusive", 0, "")
+	if err != nil {
+		t.Fatalf("failed to send message: %v", err)
+	}
+	msgID := abusive.ID
+	repo.SaveChatMsg(ctx, alice.ID, bob.ID, "please stop", 0, "")
+
+	report := map[string]interface{}{"user_id": bob.ID, "message_id": msgID, "reason": "harassment"}
+	if w := authedCall(bob.Token, http.MethodPost, "/reports", map[string]interface{}{"user_id": alice.ID, "message_id": msgID, "reason": "x"}, reportsHandler(db)); w.Code != http.StatusNotFound {
+		t.Fatalf("expected reporting someone else's message as theirs to fail, got %d", w.Code)
+	}
+	if w := authedCall(alice.Token, http.MethodPost, "/reports", report, reportsHandler(db)); w.Code != http.StatusCreated {
+		t.Fatalf("expected the report to be filed, got %d: %s", w.Code, w.Body.String())
+	}
+	if w := authedCall(alice.Token, http.MethodPost, "/reports", report, reportsHandler(db)); w.Code != http.StatusConflict {
+		t.Fatalf("expected a duplicate open report to be rejected, got %d", w.Code)
+	}
+
+	if w := authedCall(alice.Token, http.MethodGet, "/moderation/reports", nil, moderationQueueHandler(db)); w.Code != http.StatusForbidden {
+		t.Fatalf("expected regular users to be kept out of the queue, got %d", w.Code)
+	}
+	w := authedCall(mod.Token, http.MethodGet, "/moderation/reports?limit=100", nil, moderationQueueHandler(db))
+	var queue struct {
+		Reports []QueuedReport `json:"reports"`
+	}
+	json.Unmarshal(w.Body.Bytes(), &queue)
+	var queued *QueuedReport
+	for i := range queue.Reports {
+		if queue.Reports[i].ReportedUserID == bob.ID {
+			queued = &queue.Reports[i]
+		}
+	}
+	if queued == nil || len(queued.Context) != 3 || queued.Context[1].ID != msgID {
+		t.Fatalf("expected the report with its chat context, got %s", w.Body.String())
+	}
+
+	action := map[string]interface{}{"action": "ban", "reason": "harassment", "report_id": queued.ID}
+	if w := authedCall(mod.Token, http.MethodPost, fmt.Sprintf("/moderation/users/%d/actions", bob.ID), action, moderationActionsRouter(db)); w.Code != http.StatusCreated {
+		t.Fatalf("expected the ban to succeed, got %d: %s", w.Code, w.Body.String())
+	}
+
+	var status string
+	db.QueryRow(`SELECT status FROM reports WHERE id = $1`, queued.ID).Scan(&status)
+	if status != "resolved" {
+		t.Fatalf("expected the report to be resolved, got %q", status)
+	}
+	if w := authedCall(bob.Token, http.MethodGet, "/me/warnings", nil, myWarningsHandler(db)); w.Code != http.StatusForbidden {
+		t.Fatalf("expected the banned user to be rejected, got %d", w.Code)
+	}
+}
+
+// promoteTestUser gives the user a role and returns a token carrying it
+func promoteTestUser(t *testing.T, user TestUser, email, role string) string {
+	t.Helper()
+	if _, err := db.Exec(`UPDATE users SET role = $2 WHERE id = $1`, user.ID, role); err != nil {
+		t.Fatalf("failed to promote %s: %v", email, err)
+	}
+	token, _, err := NewAuthService(NewAuthRepository(db)).Login(context.Background(), email, "password123")
+	if err != nil {
+		t.Fatalf("failed to log %s in again: %v", email, err)
+	}
+	return token
+}
@@ -3,9 +3,210 @@ package main
 import (
 	"context"
 	"database/sql"
+	"log"
 	"net/http"
+	"sync"
+	"time"
+
+	"gitea.kood.tech/petrkubec/match-me/backend/events"
 )
 
+// presenceGracePeriod is how long a user may stay without any live connection
+// before being reported offline. Reconnecting within the window (page reload,
+// flaky network) produces no presence events at all.
+const presenceGracePeriod = 10 * time.Second
+
+// presenceTracker counts live real-time connections per user (WebSocket clients
+// and GraphQL subscriptions) and reports online/offline transitions.
+type presenceTracker struct {
+	mu       sync.Mutex
+	grace    time.Duration
+	conns    map[int]int              // userID -> open connections
+	pending  map[int]*time.Timer      // userID -> scheduled offline transition
+	lastSeen map[int]time.Time        // userID -> last transition seen by the tracker
+	queued   map[int][]presenceChange // userID -> transitions not yet emitted, present while one is being emitted
+
+	// notify is called outside the lock on every transition. Calls for one
+	// user never overlap and come in the order of the transitions.
+	notify func(userID int, online bool, at time.Time)
+}
+
+type presenceChange struct {
+	online bool
+	at     time.Time
+}
+
+func newPresenceTracker(grace time.Duration) *presenceTracker {
+	return &presenceTracker{
+		grace:    grace,
+		conns:    make(map[int]int),
+		pending:  make(map[int]*time.Timer),
+		lastSeen: make(map[int]time.Time),
+		queued:   make(map[int][]presenceChange),
+	}
+}
+
+// global tracker, fed by the WebSocket hub and the GraphQL subscriptions
+var userPresence = newPresenceTracker(presenceGracePeriod)
+
+// Connect records a new live connection for the user
+func (p *presenceTracker) Connect(userID int) {
+	p.mu.Lock()
+	p.conns[userID]++
+	cameOnline := false
+	if t, ok := p.pending[userID]; ok {
+		// Reconnected during the grace period: the user never went offline
+		t.Stop()
+		delete(p.pending, userID)
+	} else if p.conns[userID] == 1 {
+		cameOnline = true
+	}
+	now := transitionTime()
+	p.lastSeen[userID] = now
+	drain := cameOnline && p.enqueue(userID, true, now)
+	p.mu.Unlock()
+
+	if drain {
+		p.drain(userID)
+	}
+}
+
+// Disconnect records a closed connection. The offline transition is deferred
+// by the grace period.
+func (p *presenceTracker) Disconnect(userID int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.conns[userID] == 0 {
+		return
+	}
+	p.conns[userID]--
+	if p.conns[userID] > 0 {
+		return
+	}
+	delete(p.conns, userID)
+
+	var t *time.Timer
+	t = time.AfterFunc(p.grace, func() {
+		p.mu.Lock()
+		if p.pending[userID] != t || p.conns[userID] > 0 {
+			p.mu.Unlock()
+			return
+		}
+		delete(p.pending, userID)
+		now := transitionTime()
+		p.lastSeen[userID] = now
+		drain := p.enqueue(userID, false, now)
+		p.mu.Unlock()
+
+		if drain {
+			p.drain(userID)
+		}
+	})
+	p.pending[userID] = t
+}
+
+// Status reports whether the user is online. known is false when the tracker
+// has never seen the user, in which case callers fall back to last_online.
+func (p *presenceTracker) Status(userID int) (online, known bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.conns[userID] > 0 {
+		return true, true
+	}
+	if _, ok := p.pending[userID]; ok {
+		return true, true
+	}
+	_, known = p.lastSeen[userID]
+	return false, known
+}
+
+// offlineSince returns when the tracker last reported the user offline, or
+// false if the user is online or was never seen
+func (p *presenceTracker) offlineSince(userID int) (time.Time, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.conns[userID] > 0 {
+		return time.Time{}, false
+	}
+	if _, ok := p.pending[userID]; ok {
+		return time.Time{}, false
+	}
+	at, ok := p.lastSeen[userID]
+	return at, ok
+}
+
+// transitionTime is the current time at the precision Postgres stores, so
+// a persisted transition compares equal to the tracker's copy
+func transitionTime() time.Time {
+	return time.Now().Truncate(time.Microsecond)
+}
+
+// enqueue queues a transition for the user. The caller holds p.mu and must
+// drain the queue when enqueue returns true; otherwise the goroutine already
+// draining it emits the transition after the earlier ones.
+func (p *presenceTracker) enqueue(userID int, online bool, at time.Time) bool {
+	q, draining := p.queued[userID]
+	p.queued[userID] = append(q, presenceChange{online: online, at: at})
+	return !draining
+}
+
+// drain emits the user's queued transitions in order, outside the lock
+func (p *presenceTracker) drain(userID int) {
+	for {
+		p.mu.Lock()
+		q := p.queued[userID]
+		if len(q) == 0 {
+			delete(p.queued, userID)
+			p.mu.Unlock()
+			return
+		}
+		c := q[0]
+		p.queued[userID] = q[1:]
+		p.mu.Unlock()
+
+		if p.notify != nil {
+			p.notify(userID, c.online, c.at)
+		}
+	}
+}
+
+// presenceNotifier persists last_online and publishes the transition to the
+// user's accepted connections on the event bus.
+func presenceNotifier(db *sql.DB) func(userID int, online bool, at time.Time) {
+	repo := NewConnectionRepository()
+	return func(userID int, online bool, at time.Time) {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		if _, err := db.ExecContext(ctx, `UPDATE users SET last_online = $2 WHERE id = $1`, userID, at); err != nil {
+			log.Printf("presence: update last_online for user %d: %v", userID, err)
+		}
+
+		audience, err := repo.GetConnections(ctx, db, userID)
+		if err != nil {
+			log.Printf("presence: load connections for user %d: %v", userID, err)
+			return
+		}
+
+		events.Default().PublishPresence(events.Presence{
+			UserID:     userID,
+			Online:     online,
+			LastOnline: at,
+			Audience:   audience,
+		})
+	}
+}
+
+// PresenceEvent is the data of a "presence" WebSocket event
+type PresenceEvent struct {
+	UserID     int       `json:"user_id"`
+	IsOnline   bool      `json:"is_online"`
+	LastOnline time.Time `json:"last_online"`
+}
+
 func mePingHandler(db *sql.DB) http.HandlerFunc {
 	return authenticate(func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodPost {
@@ -19,13 +220,22 @@ func mePingHandler(db *sql.DB) http.HandlerFunc {
 	})
 }
 
+// isOnlineNow prefers the live connection tracker and falls back to the
+// last_online heartbeat (/me/ping) for users without a live connection. For
+// users the tracker saw go offline, only heartbeats since then count: the
+// offline transition itself is stored in last_online too.
 func isOnlineNow(ctx context.Context, db *sql.DB, userID int) (bool, error) {
+	if online, _ := userPresence.Status(userID); online {
+		return true, nil
+	}
+	offlineAt, known := userPresence.offlineSince(userID)
+
 	var online bool
 	err := db.QueryRowContext(ctx, `
-		SELECT COALESCE(last_online > NOW() - INTERVAL '90 seconds', FALSE) AS online
+		SELECT COALESCE(last_online > GREATEST(NOW() - INTERVAL '90 seconds', $2), FALSE) AS online
         FROM users
         WHERE id = $1
-	`, userID).Scan(&online)
+	`, userID, sql.NullTime{Time: offlineAt, Valid: known}).Scan(&online)
 	if err == sql.ErrNoRows {
 		return false, nil
 	}
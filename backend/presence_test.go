@@ -4,7 +4,9 @@ import (
 	"context"
 	"net/http"
 	"net/http/httptest"
+	"sync"
 	"testing"
+	"time"
 )
 
 func TestMePingHandler(t *testing.T) {
@@ -119,6 +121,31 @@ func TestIsOnlineNow(t *testing.T) {
 		}
 	})
 
+	t.Run("Heartbeats count after the tracker saw the user leave", func(t *testing.T) {
+		saved := userPresence
+		defer func() { userPresence = saved }()
+		userPresence = newPresenceTracker(time.Millisecond)
+		userPresence.notify = func(userID int, online bool, at time.Time) {
+			_, _ = db.Exec("UPDATE users SET last_online = $2 WHERE id = $1", userID, at)
+		}
+
+		userPresence.Connect(user.ID)
+		userPresence.Disconnect(user.ID)
+		time.Sleep(20 * time.Millisecond)
+
+		if online, err := isOnlineNow(context.Background(), db, user.ID); err != nil || online {
+			t.Errorf("Expected user offline once the tracker reported it, got %v (%v)", online, err)
+		}
+
+		time.Sleep(5 * time.Millisecond)
+		if _, err := db.Exec("UPDATE users SET last_online = NOW() WHERE id = $1", user.ID); err != nil {
+			t.Fatalf("Failed to update last_online: %v", err)
+		}
+		if online, err := isOnlineNow(context.Background(), db, user.ID); err != nil || !online {
+			t.Errorf("Expected a later ping to count, got %v (%v)", online, err)
+		}
+	})
+
 	t.Run("User with NULL last_online", func(t *testing.T) {
 		// Create user with NULL last_online
 		userNull := createTestUser(t, "null_online@example.com", "password123")
@@ -187,3 +214,121 @@ func TestPresenceIntegration(t *testing.T) {
 		t.Error("Expected both users to be online after pings")
 	}
 }
+
+func TestPresenceTracker(t *testing.T) {
+	type transition struct {
+		userID int
+		online bool
+	}
+	var (
+		mu    sync.Mutex
+		seen  []transition
+		grace = 50 * time.Millisecond
+	)
+	tracker := newPresenceTracker(grace)
+	tracker.notify = func(userID int, online bool, _ time.Time) {
+		mu.Lock()
+		defer mu.Unlock()
+		seen = append(seen, transition{userID, online})
+	}
+	transitions := func() []transition {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]transition(nil), seen...)
+	}
+
+	if _, known := tracker.Status(1); known {
+		t.Fatal("Expected unknown status for a user never seen")
+	}
+
+	// Two connections (e.g. WS + GraphQL subscription) produce one online event
+	tracker.Connect(1)
+	tracker.Connect(1)
+	if got := transitions(); len(got) != 1 || got[0] != (transition{1, true}) {
+		t.Fatalf("Expected a single online transition, got %v", got)
+	}
+
+	t.Run("Flapping is debounced", func(t *testing.T) {
+		tracker.Disconnect(1)
+		tracker.Disconnect(1)
+		tracker.Connect(1) // reconnect within the grace period
+
+		time.Sleep(2 * grace)
+		if got := transitions(); len(got) != 1 {
+			t.Errorf("Expected no events for a quick reconnect, got %v", got)
+		}
+		if online, _ := tracker.Status(1); !online {
+			t.Error("Expected user to stay online")
+		}
+	})
+
+	t.Run("Offline after grace period", func(t *testing.T) {
+		tracker.Disconnect(1)
+		if online, _ := tracker.Status(1); !online {
+			t.Error("Expected user to count as online during the grace period")
+		}
+
+		time.Sleep(2 * grace)
+		got := transitions()
+		if len(got) != 2 || got[1] != (transition{1, false}) {
+			t.Fatalf("Expected an offline transition, got %v", got)
+		}
+		if online, known := tracker.Status(1); online || !known {
+			t.Errorf("Expected known offline status, got online=%v known=%v", online, known)
+		}
+	})
+
+	t.Run("Extra disconnect is ignored", func(t *testing.T) {
+		tracker.Disconnect(1)
+		time.Sleep(2 * grace)
+		if got := transitions(); len(got) != 2 {
+			t.Errorf("Expected no further events, got %v", got)
+		}
+	})
+}
+
+func TestPresenceTrackerOrder(t *testing.T) {
+	grace := 5 * time.Millisecond
+	tracker := newPresenceTracker(grace)
+
+	var (
+		mu      sync.Mutex
+		seen    []bool
+		entered = make(chan struct{}, 2)
+		release = make(chan struct{})
+	)
+	tracker.notify = func(userID int, online bool, _ time.Time) {
+		entered <- struct{}{}
+		if online {
+			<-release // a slow notifier, e.g. a stalled database
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		seen = append(seen, online)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		tracker.Connect(1)
+		close(done)
+	}()
+	<-entered
+
+	// The offline transition happens while the online one is still being
+	// emitted. It must wait its turn instead of overtaking it.
+	tracker.Disconnect(1)
+	time.Sleep(4 * grace)
+	select {
+	case <-entered:
+		t.Fatal("Expected the offline transition to wait for the online one")
+	default:
+	}
+
+	close(release)
+	<-done
+	mu.Lock()
+	defer mu.Unlock()
+	if len(seen) != 2 || !seen[0] || seen[1] {
+		t.Errorf("Expected online then offline, got %v", seen)
+	}
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net"
+	"net/http"
+	"net/netip"
+	"os"
+	"strconv"
+	"strings"
+
+	"gitea.kood.tech/petrkubec/match-me/backend/graph"
+)
+
+const clientIPKey UserIDKey = "clientIP"
+
+// rateLimit limits the handler per client IP with the action's rule
+func rateLimit(action string, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err := rateLimiter.Allow(r.Context(), action, clientIP(r)); err != nil {
+			writeRateLimited(w, err.(*RateLimitError))
+			return
+		}
+		next(w, r)
+	}
+}
+
+// writeRateLimited answers 429 with a Retry-After header
+func writeRateLimited(w http.ResponseWriter, err *RateLimitError) {
+	w.Header().Set("Retry-After", strconv.Itoa(err.RetryAfterSeconds()))
+	writeError(w, http.StatusTooManyRequests, err.Code)
+}
+
+// trustedProxies is built once at startup from the TRUSTED_PROXIES env var, a
+// comma-separated list of IPs or CIDRs (e.g. the nginx container's network).
+var trustedProxies = buildTrustedProxies(os.Getenv("TRUSTED_PROXIES"))
+
+func buildTrustedProxies(env string) []netip.Prefix {
+	var proxies []netip.Prefix
+	for _, p := range strings.Split(env, ",") {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		if prefix, err := netip.ParsePrefix(p); err == nil {
+			proxies = append(proxies, prefix.Masked())
+		} else if addr, err := netip.ParseAddr(p); err == nil {
+			proxies = append(proxies, netip.PrefixFrom(addr, addr.BitLen()))
+		} else {
+			log.Printf("TRUSTED_PROXIES: ignoring invalid entry %q", p)
+		}
+	}
+	return proxies
+}
+
+func isTrustedProxy(ip string) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, p := range trustedProxies {
+		if p.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// clientIP is the address the request came from. Forwarding headers are only
+// read when the peer is a trusted proxy, since clients could set them to
+// dodge the limits. X-Forwarded-For is walked from the right, skipping
+// further trusted proxies; X-Real-IP is the fallback.
+func clientIP(r *http.Request) string {
+	peer, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		peer = r.RemoteAddr
+	}
+	if !isTrustedProxy(peer) {
+		return peer
+	}
+	if fwd := r.Header.Values("X-Forwarded-For"); len(fwd) > 0 {
+		hops := strings.Split(strings.Join(fwd, ","), ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			hop := strings.TrimSpace(hops[i])
+			if _, err := netip.ParseAddr(hop); err != nil {
+				break
+			}
+			if !isTrustedProxy(hop) {
+				return hop
+			}
+		}
+	}
+	if real := strings.TrimSpace(r.Header.Get("X-Real-IP")); real != "" {
+		if _, err := netip.ParseAddr(real); err == nil {
+			return real
+		}
+	}
+	return peer
+}
+
+// withClientIP puts the client IP in the request context for GraphQL, whose
+// resolvers see no request
+func withClientIP(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), clientIPKey, clientIP(r))))
+	})
+}
+
+// graphRateLimiter adapts the rate limiter to the GraphQL layer
+type graphRateLimiter struct {
+	*RateLimiter
+}
+
+var _ graph.RateLimiter = graphRateLimiter{}
+
+func (g graphRateLimiter) AllowClient(ctx context.Context, action string) error {
+	ip, _ := ctx.Value(clientIPKey).(string)
+	return g.Allow(ctx, action, ip)
+}
@@ -0,0 +1,229 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"time"
+)
+
+// RateLimitStore keeps the token buckets and login failure counts behind the
+// RateLimiter. The in-memory store is per process; the Postgres one is shared
+// by every replica.
+type RateLimitStore interface {
+	// Take refills key's bucket and takes a token if one is left. Otherwise
+	// it returns how long until the next token.
+	Take(ctx context.Context, key string, rule RateRule, now time.Time) (bool, time.Duration, error)
+
+	// GetLockout returns key's failure count and lock expiry. Failures older
+	// than the reset window no longer count.
+	GetLockout(ctx context.Context, key string, now time.Time) (Lockout, error)
+	// RecordFailure counts a failed attempt and locks key for lockFor(count)
+	// when that is positive
+	RecordFailure(ctx context.Context, key string, now time.Time, lockFor func(failures int) time.Duration) (Lockout, error)
+	ClearFailures(ctx context.Context, key string) error
+}
+
+// Lockout is the failed-attempt state of one key
+type Lockout struct {
+	Failures int
+	Until    time.Time // zero when not locked
+}
+
+// staleAfter is how long unused buckets and failure counts are kept. Buckets
+// refill completely well within it, and failures older than it are forgotten.
+const staleAfter = 24 * time.Hour
+
+type bucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+type failureCount struct {
+	Lockout
+	updated time.Time
+}
+
+type memoryRateLimitStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	failures  map[string]*failureCount
+	lastSweep time.Time
+}
+
+func newMemoryRateLimitStore() RateLimitStore {
+	return &memoryRateLimitStore{
+		buckets:  make(map[string]*bucket),
+		failures: make(map[string]*failureCount),
+	}
+}
+
+func (s *memoryRateLimitStore) Take(ctx context.Context, key string, rule RateRule, now time.Time) (bool, time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sweep(now)
+
+	b := s.buckets[key]
+	if b == nil {
+		b = &bucket{tokens: float64(rule.Burst), updated: now}
+		s.buckets[key] = b
+	}
+	var ok bool
+	var retry time.Duration
+	b.tokens, ok, retry = rule.take(b.tokens, b.updated, now)
+	b.updated = now
+	return ok, retry, nil
+}
+
+func (s *memoryRateLimitStore) GetLockout(ctx context.Context, key string, now time.Time) (Lockout, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f := s.failures[key]
+	if f == nil || now.Sub(f.updated) > staleAfter {
+		return Lockout{}, nil
+	}
+	return f.Lockout, nil
+}
+
+func (s *memoryRateLimitStore) RecordFailure(ctx context.Context, key string, now time.Time, lockFor func(int) time.Duration) (Lockout, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f := s.failures[key]
+	if f == nil || now.Sub(f.updated) > staleAfter {
+		f = &failureCount{}
+		s.failures[key] = f
+	}
+	f.Failures++
+	f.updated = now
+	if d := lockFor(f.Failures); d > 0 {
+		f.Until = now.Add(d)
+	}
+	return f.Lockout, nil
+}
+
+func (s *memoryRateLimitStore) ClearFailures(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.failures, key)
+	return nil
+}
+
+// sweep drops stale entries, at most once an hour
+func (s *memoryRateLimitStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < time.Hour {
+		return
+	}
+	s.lastSweep = now
+	for key, b := range s.buckets {
+		if now.Sub(b.updated) > staleAfter {
+			delete(s.buckets, key)
+		}
+	}
+	for key, f := range s.failures {
+		if now.Sub(f.updated) > staleAfter {
+			delete(s.failures, key)
+		}
+	}
+}
+
+type sqlRateLimitRepo struct {
+	db *sql.DB
+
+	mu        sync.Mutex
+	lastSweep time.Time
+}
+
+// NewRateLimitRepository returns the Postgres-backed store
+func NewRateLimitRepository(db *sql.DB) RateLimitStore {
+	return &sqlRateLimitRepo{db: db}
+}
+
+func (r *sqlRateLimitRepo) Take(ctx context.Context, key string, rule RateRule, now time.Time) (bool, time.Duration, error) {
+	r.sweep(ctx, now)
+
+	var ok bool
+	var retry time.Duration
+	err := withTx(ctx, r.db, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO rate_limit_buckets (key, tokens, updated_at) VALUES ($1, $2, $3)
+			ON CONFLICT (key) DO NOTHING
+		`, key, float64(rule.Burst), now); err != nil {
+			return err
+		}
+		var b bucket
+		if err := tx.QueryRowContext(ctx, `
+			SELECT tokens, updated_at FROM rate_limit_buckets WHERE key = $1 FOR UPDATE
+		`, key).Scan(&b.tokens, &b.updated); err != nil {
+			return err
+		}
+		b.tokens, ok, retry = rule.take(b.tokens, b.updated, now)
+		_, err := tx.ExecContext(ctx, `
+			UPDATE rate_limit_buckets SET tokens = $2, updated_at = $3 WHERE key = $1
+		`, key, b.tokens, now)
+		return err
+	})
+	return ok, retry, err
+}
+
+func (r *sqlRateLimitRepo) GetLockout(ctx context.Context, key string, now time.Time) (Lockout, error) {
+	var l Lockout
+	var until sql.NullTime
+	err := r.db.QueryRowContext(ctx, `
+		SELECT failures, locked_until FROM login_failures
+		WHERE key = $1 AND updated_at > $2
+	`, key, now.Add(-staleAfter)).Scan(&l.Failures, &until)
+	if err == sql.ErrNoRows {
+		return Lockout{}, nil
+	}
+	l.Until = until.Time
+	return l, err
+}
+
+func (r *sqlRateLimitRepo) RecordFailure(ctx context.Context, key string, now time.Time, lockFor func(int) time.Duration) (Lockout, error) {
+	var l Lockout
+	err := withTx(ctx, r.db, func(tx *sql.Tx) error {
+		// Failures older than staleAfter start the count over
+		var until sql.NullTime
+		if err := tx.QueryRowContext(ctx, `
+			INSERT INTO login_failures (key, failures, updated_at) VALUES ($1, 1, $2)
+			ON CONFLICT (key) DO UPDATE SET
+				failures = CASE WHEN login_failures.updated_at > $3 THEN login_failures.failures + 1 ELSE 1 END,
+				locked_until = CASE WHEN login_failures.updated_at > $3 THEN login_failures.locked_until END,
+				updated_at = $2
+			RETURNING failures, locked_until
+		`, key, now, now.Add(-staleAfter)).Scan(&l.Failures, &until); err != nil {
+			return err
+		}
+		l.Until = until.Time
+		d := lockFor(l.Failures)
+		if d <= 0 {
+			return nil
+		}
+		l.Until = now.Add(d)
+		_, err := tx.ExecContext(ctx, `UPDATE login_failures SET locked_until = $2 WHERE key = $1`, key, l.Until)
+		return err
+	})
+	return l, err
+}
+
+func (r *sqlRateLimitRepo) ClearFailures(ctx context.Context, key string) error {
+	_, err := r.db.ExecContext(ctx, `DELETE FROM login_failures WHERE key = $1`, key)
+	return err
+}
+
+// sweep deletes stale rows, at most once an hour per process
+func (r *sqlRateLimitRepo) sweep(ctx context.Context, now time.Time) {
+	r.mu.Lock()
+	if now.Sub(r.lastSweep) < time.Hour {
+		r.mu.Unlock()
+		return
+	}
+	r.lastSweep = now
+	r.mu.Unlock()
+
+	cutoff := now.Add(-staleAfter)
+	_, _ = r.db.ExecContext(ctx, `DELETE FROM rate_limit_buckets WHERE updated_at < $1`, cutoff)
+	_, _ = r.db.ExecContext(ctx, `DELETE FROM login_failures WHERE updated_at < $1`, cutoff)
+}
@@ -0,0 +1,184 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"math"
+	"strings"
+	"time"
+
+	"gitea.kood.tech/petrkubec/match-me/backend/events"
+)
+
+// RateRule is a token bucket: Burst requests at once, then one more every
+// Every
+type RateRule struct {
+	Burst int
+	Every time.Duration
+}
+
+// take refills tokens for the time since updated and takes one if a whole
+// token is there. Otherwise it returns how long until one is.
+func (r RateRule) take(tokens float64, updated, now time.Time) (float64, bool, time.Duration) {
+	if elapsed := now.Sub(updated); elapsed > 0 {
+		tokens = math.Min(float64(r.Burst), tokens+float64(elapsed)/float64(r.Every))
+	}
+	if tokens >= 1 {
+		return tokens - 1, true, 0
+	}
+	return tokens, false, time.Duration((1 - tokens) * float64(r.Every))
+}
+
+// Rate limited actions and what their buckets are keyed by
+const (
+	limitLogin    = "login"    // client IP
+	limitRegister = "register" // client IP
+	limitWSSend   = "ws_send"  // user
+	limitWSAck    = "ws_ack"   // user
+)
+
+var rateRules = map[string]RateRule{
+	limitLogin:    {Burst: 10, Every: 6 * time.Second},        // 10 a minute
+	limitRegister: {Burst: 5, Every: 12 * time.Minute},        // 5 an hour
+	limitWSSend:   {Burst: 20, Every: 500 * time.Millisecond}, // 2 a second
+	limitWSAck:    {Burst: 60, Every: 100 * time.Millisecond}, // 10 a second
+}
+
+// Progressive lockout: lockoutThreshold failed logins in a row lock the
+// account for lockoutBase. Every further failure doubles that, up to
+// lockoutMax. A successful login, or a day without failures, starts over.
+const (
+	lockoutThreshold = 5
+	lockoutBase      = time.Minute
+	lockoutMax       = time.Hour
+)
+
+func lockoutFor(failures int) time.Duration {
+	if failures < lockoutThreshold {
+		return 0
+	}
+	return min(lockoutBase<<min(failures-lockoutThreshold, 6), lockoutMax)
+}
+
+// Error codes of RateLimitError
+const (
+	codeRateLimited   = "rate_limited"
+	codeAccountLocked = "account_locked"
+)
+
+// RateLimitError is returned when a limit turns a request away. Error is the
+// response code.
+type RateLimitError struct {
+	Code string
+	Wait time.Duration
+}
+
+func (e *RateLimitError) Error() string { return e.Code }
+
+// RetryAfterSeconds is the Retry-After value: Wait rounded up
+func (e *RateLimitError) RetryAfterSeconds() int {
+	return int(math.Ceil(e.Wait.Seconds()))
+}
+
+// RateLimiter applies the rate rules and the login lockout. Every rejection
+// and every lockout is published as a SecurityAudit event.
+type RateLimiter struct {
+	store RateLimitStore
+	rules map[string]RateRule
+	now   func() time.Time
+}
+
+func NewRateLimiter(store RateLimitStore) *RateLimiter {
+	return &RateLimiter{store: store, rules: rateRules, now: time.Now}
+}
+
+// rateLimiter is shared by the HTTP middleware, the auth service, GraphQL and
+// the WebSocket reader. main switches it to the Postgres store when
+// RATE_LIMIT_STORE=postgres.
+var rateLimiter = NewRateLimiter(newMemoryRateLimitStore())
+
+// Allow takes a token from key's bucket for the action. If the store fails
+// the request is let through: a limiter outage must not take logins down.
+func (l *RateLimiter) Allow(ctx context.Context, action, key string) error {
+	rule, ok := l.rules[action]
+	if !ok {
+		return nil
+	}
+	key = action + ":" + key
+	now := l.now()
+	ok, wait, err := l.store.Take(ctx, key, rule, now)
+	if err != nil {
+		log.Println("rate limiter error:", err)
+		return nil
+	}
+	if ok {
+		return nil
+	}
+	l.audit(codeRateLimited, key, now.Add(wait))
+	return &RateLimitError{Code: codeRateLimited, Wait: wait}
+}
+
+// CheckLockout fails with account_locked while the account is locked
+func (l *RateLimiter) CheckLockout(ctx context.Context, email string) error {
+	now := l.now()
+	lock, err := l.store.GetLockout(ctx, accountKey(email), now)
+	if err != nil {
+		log.Println("rate limiter error:", err)
+		return nil
+	}
+	if lock.Until.After(now) {
+		return &RateLimitError{Code: codeAccountLocked, Wait: lock.Until.Sub(now)}
+	}
+	return nil
+}
+
+// LoginFailed counts a failed login for the account. When that locks the
+// account it returns the account_locked error.
+func (l *RateLimiter) LoginFailed(ctx context.Context, email string) error {
+	key, now := accountKey(email), l.now()
+	lock, err := l.store.RecordFailure(ctx, key, now, lockoutFor)
+	if err != nil {
+		log.Println("rate limiter error:", err)
+		return nil
+	}
+	if !lock.Until.After(now) {
+		return nil
+	}
+	l.audit(codeAccountLocked, key, lock.Until)
+	return &RateLimitError{Code: codeAccountLocked, Wait: lock.Until.Sub(now)}
+}
+
+// LoginSucceeded forgets the account's failed logins
+func (l *RateLimiter) LoginSucceeded(ctx context.Context, email string) {
+	if err := l.store.ClearFailures(ctx, accountKey(email)); err != nil {
+		log.Println("rate limiter error:", err)
+	}
+}
+
+func (l *RateLimiter) audit(action, key string, until time.Time) {
+	events.Default().PublishAudit(events.Audit{Action: action, Key: key, Until: until})
+}
+
+// accountKey names the account by email, whether or not it exists, so
+// lockouts do not reveal which emails are registered
+func accountKey(email string) string {
+	return "login:account:" + strings.ToLower(strings.TrimSpace(email))
+}
+
+// logAuditEvent writes SecurityAudit events to the log
+func logAuditEvent(evt events.Event) {
+	if evt.Type != events.SecurityAudit {
+		return
+	}
+	if a, ok := evt.Payload.(events.Audit); ok {
+		log.Printf("audit: %s %s until %s", a.Action, a.Key, a.Until.Format(time.RFC3339))
+	}
+}
+
+// asRateLimitError unwraps a RateLimitError
+func asRateLimitError(err error) (*RateLimitError, bool) {
+	var rl *RateLimitError
+	ok := errors.As(err, &rl)
+	return rl, ok
+}
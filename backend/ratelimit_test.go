@@ -0,0 +1,162 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func newTestRateLimiter(now *time.Time) *RateLimiter {
+	l := NewRateLimiter(newMemoryRateLimitStore())
+	l.now = func() time.Time { return *now }
+	return l
+}
+
+func TestRateLimiter(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(1_000_000, 0)
+	l := newTestRateLimiter(&now)
+	l.rules = map[string]RateRule{"test": {Burst: 2, Every: 10 * time.Second}}
+
+	t.Run("Burst then refill", func(t *testing.T) {
+		for i := 0; i < 2; i++ {
+			if err := l.Allow(ctx, "test", "a"); err != nil {
+				t.Fatalf("Expected request %d within the burst, got %v", i+1, err)
+			}
+		}
+		err := l.Allow(ctx, "test", "a")
+		rl, ok := asRateLimitError(err)
+		if !ok || rl.Code != codeRateLimited || rl.RetryAfterSeconds() != 10 {
+			t.Fatalf("Expected rate_limited with a 10s wait, got %v", err)
+		}
+		if err := l.Allow(ctx, "test", "b"); err != nil {
+			t.Fatalf("Expected keys to have their own buckets, got %v", err)
+		}
+
+		now = now.Add(5 * time.Second)
+		if rl, _ := asRateLimitError(l.Allow(ctx, "test", "a")); rl == nil || rl.RetryAfterSeconds() != 5 {
+			t.Fatalf("Expected half a token refilled, got %+v", rl)
+		}
+		now = now.Add(5 * time.Second)
+		if err := l.Allow(ctx, "test", "a"); err != nil {
+			t.Fatalf("Expected a token after refill, got %v", err)
+		}
+	})
+
+	t.Run("Unknown actions are not limited", func(t *testing.T) {
+		for i := 0; i < 5; i++ {
+			if err := l.Allow(ctx, "other", "a"); err != nil {
+				t.Fatalf("Expected no limit, got %v", err)
+			}
+		}
+	})
+}
+
+func TestLoginLockout(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(1_000_000, 0)
+	l := newTestRateLimiter(&now)
+
+	for i := 1; i < lockoutThreshold; i++ {
+		if err := l.LoginFailed(ctx, "a@example.com"); err != nil {
+			t.Fatalf("Expected no lockout after %d failures, got %v", i, err)
+		}
+	}
+	err := l.LoginFailed(ctx, "A@example.com ")
+	if rl, ok := asRateLimitError(err); !ok || rl.Code != codeAccountLocked || rl.Wait != lockoutBase {
+		t.Fatalf("Expected a %v lockout at the threshold, got %v", lockoutBase, err)
+	}
+	if err := l.CheckLockout(ctx, "a@example.com"); err == nil {
+		t.Fatal("Expected the account to be locked")
+	}
+	if err := l.CheckLockout(ctx, "b@example.com"); err != nil {
+		t.Fatalf("Expected other accounts unaffected, got %v", err)
+	}
+
+	now = now.Add(lockoutBase)
+	if err := l.CheckLockout(ctx, "a@example.com"); err != nil {
+		t.Fatalf("Expected the lockout to expire, got %v", err)
+	}
+	if rl, _ := asRateLimitError(l.LoginFailed(ctx, "a@example.com")); rl == nil || rl.Wait != 2*lockoutBase {
+		t.Fatalf("Expected the next failure to double the lockout, got %+v", rl)
+	}
+
+	l.LoginSucceeded(ctx, "a@example.com")
+	if err := l.LoginFailed(ctx, "a@example.com"); err != nil {
+		t.Fatalf("Expected a success to reset the count, got %v", err)
+	}
+
+	if got := lockoutFor(lockoutThreshold + 20); got != lockoutMax {
+		t.Fatalf("Expected lockouts capped at %v, got %v", lockoutMax, got)
+	}
+}
+
+func TestRateLimitMiddleware(t *testing.T) {
+	saved := rateLimiter
+	defer func() { rateLimiter = saved }()
+	rateLimiter = NewRateLimiter(newMemoryRateLimitStore())
+	rateLimiter.rules = map[string]RateRule{"test": {Burst: 1, Every: time.Minute}}
+
+	h := rateLimit("test", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	do := func(addr string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/login", nil)
+		req.RemoteAddr = addr
+		w := httptest.NewRecorder()
+		h(w, req)
+		return w
+	}
+
+	if w := do("10.0.0.1:1000"); w.Code != http.StatusNoContent {
+		t.Fatalf("Expected the first request through, got %d", w.Code)
+	}
+	w := do("10.0.0.1:2000")
+	if w.Code != http.StatusTooManyRequests || w.Header().Get("Retry-After") != "60" {
+		t.Fatalf("Expected 429 with Retry-After 60 for the same IP, got %d %q", w.Code, w.Header().Get("Retry-After"))
+	}
+	if w := do("10.0.0.2:1000"); w.Code != http.StatusNoContent {
+		t.Fatalf("Expected another IP through, got %d", w.Code)
+	}
+}
+
+func TestClientIP(t *testing.T) {
+	saved := trustedProxies
+	defer func() { trustedProxies = saved }()
+	trustedProxies = buildTrustedProxies("172.18.0.0/16, 10.0.0.5, not-an-ip")
+
+	tests := []struct {
+		name    string
+		remote  string
+		headers map[string]string
+		want    string
+	}{
+		{"direct client", "203.0.113.7:1000", nil, "203.0.113.7"},
+		{"untrusted peer's headers are ignored", "203.0.113.7:1000",
+			map[string]string{"X-Forwarded-For": "198.51.100.1", "X-Real-IP": "198.51.100.1"}, "203.0.113.7"},
+		{"forwarded by a trusted proxy", "172.18.0.3:1000",
+			map[string]string{"X-Forwarded-For": "198.51.100.1"}, "198.51.100.1"},
+		{"spoofed hops left of the proxy's are skipped", "172.18.0.3:1000",
+			map[string]string{"X-Forwarded-For": "1.2.3.4, 198.51.100.1"}, "198.51.100.1"},
+		{"trusted hops are walked past", "172.18.0.3:1000",
+			map[string]string{"X-Forwarded-For": "198.51.100.1, 10.0.0.5"}, "198.51.100.1"},
+		{"X-Real-IP without X-Forwarded-For", "10.0.0.5:1000",
+			map[string]string{"X-Real-IP": "198.51.100.2"}, "198.51.100.2"},
+		{"garbage falls back to the peer", "172.18.0.3:1000",
+			map[string]string{"X-Forwarded-For": "garbage", "X-Real-IP": "garbage"}, "172.18.0.3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/login", nil)
+			req.RemoteAddr = tt.remote
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+			if got := clientIP(req); got != tt.want {
+				t.Errorf("clientIP() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
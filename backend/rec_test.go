@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -327,3 +328,44 @@ func testDismissals(t *testing.T) {
 		}
 	})
 }
+
+func TestCandidatesWithoutCoordinates(t *testing.T) {
+	viewer := createTestUser(t, "rec_nocoords_viewer@example.com", "password123")
+	located := createTestUser(t, "rec_nocoords_located@example.com", "password123")
+	unlocated := createTestUser(t, "rec_nocoords_unlocated@example.com", "password123")
+	defer cleanupTestData(viewer.Email, located.Email, unlocated.Email)
+
+	profile := getDefaultTestProfile()
+	createTestProfile(t, viewer, profile)
+	createTestProfile(t, located, profile)
+	createTestProfile(t, unlocated, profile)
+
+	// A radius with nothing to measure it from: left out explicitly rather
+	// than by the radius check evaluating to NULL
+	if _, err := db.Exec("UPDATE profiles SET location_lat = NULL, location_lon = NULL WHERE user_id = $1", unlocated.ID); err != nil {
+		t.Fatalf("failed to clear coordinates: %v", err)
+	}
+
+	rows, err := NewRecommendationRepository(db).GetCandidateProfiles(context.Background(), viewer.ID, profile.LocationLat, profile.LocationLon, nil)
+	if err != nil {
+		t.Fatalf("failed to list candidates: %v", err)
+	}
+	defer rows.Close()
+
+	seen := map[int]bool{}
+	for rows.Next() {
+		var c Profile
+		var analog, digital, prefs []byte
+		if err := rows.Scan(&c.UserID, &analog, &digital, &c.CrossPollination, &c.FavoriteFood, &c.FavoriteMusic,
+			&c.LocationLat, &c.LocationLon, &c.MaxRadiusKm, &prefs); err != nil {
+			t.Fatalf("failed to scan candidate: %v", err)
+		}
+		seen[c.UserID] = true
+	}
+	if !seen[located.ID] {
+		t.Error("expected the candidate with coordinates to be listed")
+	}
+	if seen[unlocated.ID] {
+		t.Error("expected the candidate without coordinates to be left out")
+	}
+}
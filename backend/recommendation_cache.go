@@ -0,0 +1,149 @@
+package main
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"gitea.kood.tech/petrkubec/match-me/backend/events"
+)
+
+// Recommendations are materialized per user in user_recommendations and kept
+// fresh by a background worker. Writes that can change someone's list publish
+// an event; the worker marks the affected users stale and recomputes them.
+const (
+	// recommendationRefreshInterval is how often the worker looks for stale lists
+	recommendationRefreshInterval = 5 * time.Second
+	// recommendationMaxAge forces a periodic refresh so newly completed
+	// profiles show up in lists that were not invalidated by anything else
+	recommendationMaxAge = 15 * time.Minute
+	// recommendationClaimTimeout lets another replica pick up a list whose
+	// refresh was claimed but never finished
+	recommendationClaimTimeout = 2 * time.Minute
+	// recommendationRefreshBatch caps the lists refreshed per tick
+	recommendationRefreshBatch = 50
+)
+
+// recommendationWorker collects invalidations from the event bus and refreshes
+// the affected lists. Events only touch the in-memory dirty sets, so the bus
+// handler never blocks; the database work happens on the worker goroutine.
+type recommendationWorker struct {
+	repo RecommendationRepository
+	svc  RecommendationService
+
+	mu         sync.Mutex
+	users      map[int]struct{} // lists to recompute
+	candidates map[int]struct{} // users whose appearance in other lists is outdated
+	wake       chan struct{}
+}
+
+func newRecommendationWorker(repo RecommendationRepository, svc RecommendationService) *recommendationWorker {
+	return &recommendationWorker{
+		repo:       repo,
+		svc:        svc,
+		users:      make(map[int]struct{}),
+		candidates: make(map[int]struct{}),
+		wake:       make(chan struct{}, 1),
+	}
+}
+
+// handleEvent marks the lists affected by a profile, connection, dismissal,
+// block or account status change
+func (w *recommendationWorker) handleEvent(evt events.Event) {
+	w.mu.Lock()
+	switch p := evt.Payload.(type) {
+	case events.Profile:
+		w.users[p.UserID] = struct{}{}
+		w.candidates[p.UserID] = struct{}{}
+	case events.Connection:
+		w.users[p.UserID] = struct{}{}
+		w.users[p.TargetID] = struct{}{}
+	case events.Dismissal:
+		w.users[p.UserID] = struct{}{}
+	case events.Block:
+		w.users[p.UserID] = struct{}{}
+		w.users[p.BlockedID] = struct{}{}
+	case events.Account:
+		// Banned users drop out of every list they appear in
+		w.candidates[p.UserID] = struct{}{}
+	default:
+		w.mu.Unlock()
+		return
+	}
+	w.mu.Unlock()
+	w.nudge()
+}
+
+func (w *recommendationWorker) nudge() {
+	select {
+	case w.wake <- struct{}{}:
+	default:
+	}
+}
+
+// drain takes the pending invalidations
+func (w *recommendationWorker) drain() (users, candidates []int) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for id := range w.users {
+		users = append(users, id)
+	}
+	for id := range w.candidates {
+		candidates = append(candidates, id)
+	}
+	w.users = make(map[int]struct{})
+	w.candidates = make(map[int]struct{})
+	return users, candidates
+}
+
+// Run refreshes stale lists until ctx is cancelled
+func (w *recommendationWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(recommendationRefreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-w.wake:
+		}
+		w.tick(ctx)
+	}
+}
+
+func (w *recommendationWorker) tick(ctx context.Context) {
+	if users, candidates := w.drain(); len(users) > 0 || len(candidates) > 0 {
+		if err := w.repo.MarkRecommendationsStale(ctx, users, candidates); err != nil {
+			log.Printf("recommendations: mark stale: %v", err)
+			// keep them for the next tick
+			w.mu.Lock()
+			for _, id := range users {
+				w.users[id] = struct{}{}
+			}
+			for _, id := range candidates {
+				w.candidates[id] = struct{}{}
+			}
+			w.mu.Unlock()
+			return
+		}
+	}
+
+	ids, err := w.repo.ClaimStaleRecommendations(ctx, recommendationRefreshBatch, recommendationMaxAge, recommendationClaimTimeout)
+	if err != nil {
+		log.Printf("recommendations: claim stale: %v", err)
+		return
+	}
+	for _, id := range ids {
+		if _, err := w.svc.RefreshRecommendations(ctx, id); err != nil {
+			log.Printf("recommendations: refresh user %d: %v", id, err)
+			if err := w.repo.ReleaseRecommendations(ctx, id); err != nil {
+				log.Printf("recommendations: release user %d: %v", id, err)
+			}
+		}
+	}
+	if len(ids) == recommendationRefreshBatch {
+		// more work is probably waiting
+		w.nudge()
+	}
+}
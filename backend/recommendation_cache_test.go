@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"gitea.kood.tech/petrkubec/match-me/backend/events"
+)
+
+type stubCacheRepo struct {
+	RecommendationRepository
+	staleUsers, staleCandidates []int
+	claimed                     []int
+	released                    []int
+}
+
+func (r *stubCacheRepo) MarkRecommendationsStale(ctx context.Context, userIDs, candidateIDs []int) error {
+	r.staleUsers = append(r.staleUsers, userIDs...)
+	r.staleCandidates = append(r.staleCandidates, candidateIDs...)
+	return nil
+}
+
+func (r *stubCacheRepo) ClaimStaleRecommendations(ctx context.Context, limit int, maxAge, claimTimeout time.Duration) ([]int, error) {
+	return r.claimed, nil
+}
+
+func (r *stubCacheRepo) ReleaseRecommendations(ctx context.Context, userID int) error {
+	r.released = append(r.released, userID)
+	return nil
+}
+
+type stubRefreshService struct {
+	RecommendationService
+	refreshed []int
+	failFor   int
+}
+
+func (s *stubRefreshService) RefreshRecommendations(ctx context.Context, userID int) ([]RecommendationResult, error) {
+	if userID == s.failFor {
+		return nil, errors.New("boom")
+	}
+	s.refreshed = append(s.refreshed, userID)
+	return nil, nil
+}
+
+func TestRecommendationWorkerEvents(t *testing.T) {
+	w := newRecommendationWorker(nil, nil)
+	w.handleEvent(events.Event{Type: events.ProfileChanged, Payload: events.Profile{UserID: 1}})
+	w.handleEvent(events.Event{Type: events.ConnectionChanged, Payload: events.Connection{UserID: 2, TargetID: 3, Status: "pending"}})
+	w.handleEvent(events.Event{Type: events.RecommendationDismissed, Payload: events.Dismissal{UserID: 4, DismissedUserID: 5}})
+	w.handleEvent(events.Event{Type: events.MessageCreated, Payload: events.Message{SenderID: 6}})
+
+	users, candidates := w.drain()
+	sort.Ints(users)
+	if len(users) != 4 || users[0] != 1 || users[3] != 4 {
+		t.Fatalf("unexpected stale users %v", users)
+	}
+	if len(candidates) != 1 || candidates[0] != 1 {
+		t.Fatalf("only profile changes should invalidate other lists, got %v", candidates)
+	}
+	if users, candidates := w.drain(); len(users)+len(candidates) != 0 {
+		t.Fatal("drain should reset the dirty sets")
+	}
+}
+
+func TestRecommendationWorkerTick(t *testing.T) {
+	repo := &stubCacheRepo{claimed: []int{7, 8}}
+	svc := &stubRefreshService{failFor: 8}
+	w := newRecommendationWorker(repo, svc)
+	w.handleEvent(events.Event{Type: events.ProfileChanged, Payload: events.Profile{UserID: 7}})
+
+	w.tick(context.Background())
+
+	if len(repo.staleUsers) != 1 || len(repo.staleCandidates) != 1 {
+		t.Fatalf("expected pending invalidations to be flushed, got %v / %v", repo.staleUsers, repo.staleCandidates)
+	}
+	if len(svc.refreshed) != 1 || svc.refreshed[0] != 7 {
+		t.Fatalf("expected user 7 to be refreshed, got %v", svc.refreshed)
+	}
+	if len(repo.released) != 1 || repo.released[0] != 8 {
+		t.Fatalf("expected the failed refresh to be released, got %v", repo.released)
+	}
+}
+
+func TestRecommendationWorkerBlockEvent(t *testing.T) {
+	w := newRecommendationWorker(nil, nil)
+	w.handleEvent(events.Event{Type: events.BlockChanged, Payload: events.Block{UserID: 1, BlockedID: 2, Blocked: true}})
+
+	users, candidates := w.drain()
+	sort.Ints(users)
+	if len(users) != 2 || users[0] != 1 || users[1] != 2 || len(candidates) != 0 {
+		t.Fatalf("expected both lists to be refreshed, got %v / %v", users, candidates)
+	}
+}
+
+func TestRecommendationWorkerAccountEvent(t *testing.T) {
+	w := newRecommendationWorker(nil, nil)
+	w.handleEvent(events.Event{Type: events.AccountChanged, Payload: events.Account{UserID: 3, Status: "banned"}})
+
+	users, candidates := w.drain()
+	if len(users) != 0 || len(candidates) != 1 || candidates[0] != 3 {
+		t.Fatalf("expected the banned user to leave other lists, got %v / %v", users, candidates)
+	}
+}
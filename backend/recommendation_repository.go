@@ -3,13 +3,29 @@ package main
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/lib/pq"
 )
 
 type RecommendationRepository interface {
 	GetUserProfileData(ctx context.Context, userID int) (Profile, []byte, []byte, []byte, error)
-	GetCandidateProfiles(ctx context.Context, userID int, minLat, maxLat, minLon, maxLon *float64) (*sql.Rows, error)
+	GetCandidateProfiles(ctx context.Context, userID int, lat, lon float64, area *geoSearch) (*sql.Rows, error)
 	InsertDismissal(ctx context.Context, userID, dismissedUserID int) error
 	CheckProfileComplete(ctx context.Context, userID int) (bool, error)
+	GetScoringConfig(ctx context.Context) ([]byte, error)
+	CreateSnapshot(ctx context.Context, id string, userID int, results []byte, expiresAt time.Time) error
+	GetSnapshot(ctx context.Context, id string) (int, []byte, time.Time, error)
+	GetProfilesByIDs(ctx context.Context, ids []int) ([]Profile, error)
+
+	GetCachedRecommendations(ctx context.Context, userID int) ([]RecommendationResult, bool, error)
+	IsCachedRecommendation(ctx context.Context, userID, candidateID int) (bool, bool, error)
+	StoreRecommendations(ctx context.Context, userID int, results []RecommendationResult) error
+	DeleteRecommendations(ctx context.Context, userID int) error
+	MarkRecommendationsStale(ctx context.Context, userIDs, candidateIDs []int) error
+	ClaimStaleRecommendations(ctx context.Context, limit int, maxAge, claimTimeout time.Duration) ([]int, error)
+	ReleaseRecommendations(ctx context.Context, userID int) error
 }
 
 type sqlRecommendationRepo struct {
@@ -44,7 +60,12 @@ func (r *sqlRecommendationRepo) GetUserProfileData(ctx context.Context, userID i
 	return userProfile, analogPassions, digitalDelights, matchPrefsRaw, err
 }
 
-func (r *sqlRecommendationRepo) GetCandidateProfiles(ctx context.Context, userID int, minLat, maxLat, minLon, maxLon *float64) (*sql.Rows, error) {
+// GetCandidateProfiles lists complete, unconnected, undismissed, unblocked profiles
+// of users who are not banned, limited to area when it is not nil. Candidates whose own max_radius_km does
+// not reach the viewer at (lat, lon) are left out, so matches are possible
+// from both sides. Candidates without coordinates are left out too: neither
+// radius can be checked for them.
+func (r *sqlRecommendationRepo) GetCandidateProfiles(ctx context.Context, userID int, lat, lon float64, area *geoSearch) (*sql.Rows, error) {
 	q := `
         SELECT p.user_id,
                p.analog_passions,
@@ -53,7 +74,9 @@ func (r *sqlRecommendationRepo) GetCandidateProfiles(ctx context.Context, userID
                p.favorite_food,
                p.favorite_music,
                p.location_lat,
-               p.location_lon
+               p.location_lon,
+               COALESCE(p.max_radius_km, 0),
+               COALESCE(p.match_preferences, '{}'::jsonb)
         FROM profiles p
         WHERE p.is_complete = TRUE
           AND p.user_id <> $1
@@ -67,15 +90,36 @@ func (r *sqlRecommendationRepo) GetCandidateProfiles(ctx context.Context, userID
               SELECT 1
               FROM dismissed_recommendations d
               WHERE d.user_id = $1 AND d.dismissed_user_id = p.user_id
-          )`
+          )
+          AND NOT EXISTS (
+              SELECT 1
+              FROM blocks b
+              WHERE (b.blocker_id = $1 AND b.blocked_id = p.user_id)
+                 OR (b.blocker_id = p.user_id AND b.blocked_id = $1)
+          )
+          AND NOT EXISTS (
+              SELECT 1 FROM users u
+              WHERE u.id = p.user_id AND u.banned_at IS NOT NULL
+          )
+          AND p.location_lat IS NOT NULL AND p.location_lon IS NOT NULL
+          AND (p.max_radius_km IS NULL OR 2 * 6371 * asin(LEAST(1, sqrt(
+                  power(sin(radians(p.location_lat - $2) / 2), 2) +
+                  cos(radians($2)) * cos(radians(p.location_lat)) *
+                  power(sin(radians(p.location_lon - $3) / 2), 2)
+              ))) <= p.max_radius_km)`
 
-	args := []interface{}{userID}
+	args := []interface{}{userID, lat, lon}
 
-	if minLat != nil && maxLat != nil && minLon != nil && maxLon != nil {
+	switch {
+	case area == nil:
+	case area.Cells != nil:
 		q += `
-          AND p.location_lat BETWEEN $2 AND $3
-          AND p.location_lon BETWEEN $4 AND $5`
-		args = append(args, *minLat, *maxLat, *minLon, *maxLon)
+          AND p.geo_cell = ANY($4::int[])`
+		args = append(args, pq.Array(area.Cells))
+	default:
+		q += `
+          AND p.location_lat BETWEEN $4 AND $5`
+		args = append(args, area.MinLat, area.MaxLat)
 	}
 
 	return r.db.QueryContext(ctx, q, args...)
@@ -93,3 +137,265 @@ func (r *sqlRecommendationRepo) InsertDismissal(ctx context.Context, userID, dis
 	_, err = r.db.ExecContext(ctx, `INSERT INTO dismissed_recommendations (user_id, dismissed_user_id) VALUES ($1,$2) ON CONFLICT DO NOTHING`, userID, dismissedUserID)
 	return err
 }
+
+// GetScoringConfig returns the JSON override for the scoring config, or nil
+// when none is stored
+func (r *sqlRecommendationRepo) GetScoringConfig(ctx context.Context) ([]byte, error) {
+	var raw []byte
+	err := r.db.QueryRowContext(ctx, "SELECT config FROM scoring_config WHERE name = 'default'").Scan(&raw)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return raw, err
+}
+
+// CreateSnapshot stores a ranked result list for paging and prunes expired ones
+func (r *sqlRecommendationRepo) CreateSnapshot(ctx context.Context, id string, userID int, results []byte, expiresAt time.Time) error {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM recommendation_snapshots WHERE expires_at < NOW()`); err != nil {
+		return err
+	}
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO recommendation_snapshots (id, user_id, results, expires_at)
+		VALUES ($1, $2, $3, $4)
+	`, id, userID, results, expiresAt)
+	return err
+}
+
+// GetSnapshot returns the owner, results and expiry of a snapshot, or
+// ErrNotFound
+func (r *sqlRecommendationRepo) GetSnapshot(ctx context.Context, id string) (int, []byte, time.Time, error) {
+	var userID int
+	var results []byte
+	var expiresAt time.Time
+	err := r.db.QueryRowContext(ctx, `
+		SELECT user_id, results, expires_at FROM recommendation_snapshots WHERE id = $1
+	`, id).Scan(&userID, &results, &expiresAt)
+	if err == sql.ErrNoRows {
+		return 0, nil, time.Time{}, ErrNotFound
+	}
+	return userID, results, expiresAt, err
+}
+
+// GetProfilesByIDs loads the scoring-relevant fields of the given profiles.
+// Unknown IDs are skipped.
+func (r *sqlRecommendationRepo) GetProfilesByIDs(ctx context.Context, ids []int) ([]Profile, error) {
+	rows, err := r.db.QueryContext(ctx, `
+        SELECT user_id, analog_passions, digital_delights, COALESCE(collaboration_interests, ''),
+               COALESCE(favorite_food, ''), COALESCE(favorite_music, ''),
+               COALESCE(location_lat, 0), COALESCE(location_lon, 0), COALESCE(max_radius_km, 0),
+               COALESCE(match_preferences, '{}'::jsonb)
+        FROM profiles WHERE user_id = ANY($1::int[])
+    `, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var profiles []Profile
+	for rows.Next() {
+		var p Profile
+		var analog, digital, prefs []byte
+		if err := rows.Scan(&p.UserID, &analog, &digital, &p.CrossPollination, &p.FavoriteFood, &p.FavoriteMusic,
+			&p.LocationLat, &p.LocationLon, &p.MaxRadiusKm, &prefs); err != nil {
+			return nil, err
+		}
+		json.Unmarshal(analog, &p.AnalogPassions)
+		json.Unmarshal(digital, &p.DigitalDelights)
+		json.Unmarshal(prefs, &p.MatchPreferences)
+		profiles = append(profiles, p)
+	}
+	return profiles, rows.Err()
+}
+
+// cachedEligibleSQL drops cached rows (aliased r) that stopped being
+// recommendable since the list was computed, so a stale cache never shows
+// connected, dismissed, blocked, banned or incomplete users
+const cachedEligibleSQL = `
+          AND EXISTS (
+              SELECT 1 FROM profiles p
+              WHERE p.user_id = r.candidate_id AND p.is_complete = TRUE
+          )
+          AND NOT EXISTS (
+              SELECT 1
+              FROM connections c
+              WHERE (c.user_id = r.user_id AND c.target_user_id = r.candidate_id)
+                 OR (c.user_id = r.candidate_id AND c.target_user_id = r.user_id)
+          )
+          AND NOT EXISTS (
+              SELECT 1
+              FROM dismissed_recommendations d
+              WHERE d.user_id = r.user_id AND d.dismissed_user_id = r.candidate_id
+          )
+          AND NOT EXISTS (
+              SELECT 1
+              FROM blocks b
+              WHERE (b.blocker_id = r.user_id AND b.blocked_id = r.candidate_id)
+                 OR (b.blocker_id = r.candidate_id AND b.blocked_id = r.user_id)
+          )
+          AND NOT EXISTS (
+              SELECT 1 FROM users u
+              WHERE u.id = r.candidate_id AND u.banned_at IS NOT NULL
+          )`
+
+// GetCachedRecommendations returns the materialized list in rank order. The
+// bool is false when the list has never been computed for the user.
+func (r *sqlRecommendationRepo) GetCachedRecommendations(ctx context.Context, userID int) ([]RecommendationResult, bool, error) {
+	var computed bool
+	err := r.db.QueryRowContext(ctx, `SELECT computed_at IS NOT NULL FROM recommendation_state WHERE user_id = $1`, userID).Scan(&computed)
+	if err == sql.ErrNoRows || (err == nil && !computed) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+        SELECT r.candidate_id, r.score, r.score_percentage, COALESCE(r.distance_km, 0)
+        FROM user_recommendations r
+        WHERE r.user_id = $1`+cachedEligibleSQL+`
+        ORDER BY r.rank`, userID)
+	if err != nil {
+		return nil, false, err
+	}
+	defer rows.Close()
+
+	var results []RecommendationResult
+	for rows.Next() {
+		var res RecommendationResult
+		if err := rows.Scan(&res.UserID, &res.Score, &res.ScorePercentage, &res.Distance); err != nil {
+			return nil, false, err
+		}
+		results = append(results, res)
+	}
+	return results, true, rows.Err()
+}
+
+// IsCachedRecommendation reports whether candidateID is in the user's
+// materialized list (first bool) and whether that list exists at all (second)
+func (r *sqlRecommendationRepo) IsCachedRecommendation(ctx context.Context, userID, candidateID int) (bool, bool, error) {
+	var computed, found bool
+	err := r.db.QueryRowContext(ctx, `
+        SELECT s.computed_at IS NOT NULL,
+               EXISTS (
+                   SELECT 1 FROM user_recommendations r
+                   WHERE r.user_id = $1 AND r.candidate_id = $2`+cachedEligibleSQL+`
+               )
+        FROM recommendation_state s
+        WHERE s.user_id = $1`, userID, candidateID).Scan(&computed, &found)
+	if err == sql.ErrNoRows {
+		return false, false, nil
+	}
+	if err != nil {
+		return false, false, err
+	}
+	return found && computed, computed, nil
+}
+
+// StoreRecommendations replaces the user's materialized list. It leaves the
+// stale flag alone, so an invalidation that raced the computation still
+// triggers another refresh.
+func (r *sqlRecommendationRepo) StoreRecommendations(ctx context.Context, userID int, results []RecommendationResult) error {
+	candidates := make([]int64, len(results))
+	scores := make([]int64, len(results))
+	percentages := make([]float64, len(results))
+	distances := make([]float64, len(results))
+	for i, res := range results {
+		candidates[i] = int64(res.UserID)
+		scores[i] = int64(res.Score)
+		percentages[i] = res.ScorePercentage
+		distances[i] = res.Distance
+	}
+
+	return withTx(ctx, r.db, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM user_recommendations WHERE user_id = $1`, userID); err != nil {
+			return err
+		}
+		if len(results) > 0 {
+			_, err := tx.ExecContext(ctx, `
+				INSERT INTO user_recommendations (user_id, candidate_id, rank, score, score_percentage, distance_km)
+				SELECT $1, t.candidate_id, t.rank, t.score, t.score_percentage, NULLIF(t.distance_km, 0)
+				FROM unnest($2::int[], $3::int[], $4::float8[], $5::float8[])
+				     WITH ORDINALITY AS t(candidate_id, score, score_percentage, distance_km, rank)
+			`, userID, pq.Array(candidates), pq.Array(scores), pq.Array(percentages), pq.Array(distances))
+			if err != nil {
+				return err
+			}
+		}
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO recommendation_state (user_id, stale, computed_at)
+			VALUES ($1, FALSE, NOW())
+			ON CONFLICT (user_id) DO UPDATE SET computed_at = NOW(), claimed_at = NULL
+		`, userID)
+		return err
+	})
+}
+
+// DeleteRecommendations drops the list and state of a user who cannot
+// receive recommendations (incomplete or removed profile)
+func (r *sqlRecommendationRepo) DeleteRecommendations(ctx context.Context, userID int) error {
+	return withTx(ctx, r.db, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM user_recommendations WHERE user_id = $1`, userID); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, `DELETE FROM recommendation_state WHERE user_id = $1`, userID)
+		return err
+	})
+}
+
+// MarkRecommendationsStale queues userIDs for a refresh, together with every
+// user whose materialized list contains one of candidateIDs
+func (r *sqlRecommendationRepo) MarkRecommendationsStale(ctx context.Context, userIDs, candidateIDs []int) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO recommendation_state (user_id, stale)
+		SELECT id, TRUE FROM (
+		    SELECT unnest($1::int[]) AS id
+		    UNION
+		    SELECT user_id FROM user_recommendations WHERE candidate_id = ANY($2::int[])
+		) affected
+		WHERE EXISTS (SELECT 1 FROM users u WHERE u.id = affected.id)
+		ON CONFLICT (user_id) DO UPDATE SET stale = TRUE
+	`, pq.Array(userIDs), pq.Array(candidateIDs))
+	return err
+}
+
+// ClaimStaleRecommendations picks up to limit users whose list is stale or
+// older than maxAge and marks them as being refreshed. Claims older than
+// claimTimeout are considered abandoned, so a crashed worker's users are
+// picked up again. Safe to run from several replicas at once.
+func (r *sqlRecommendationRepo) ClaimStaleRecommendations(ctx context.Context, limit int, maxAge, claimTimeout time.Duration) ([]int, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		WITH due AS (
+		    SELECT user_id FROM recommendation_state
+		    WHERE (stale OR computed_at IS NULL OR computed_at < NOW() - $2 * INTERVAL '1 second')
+		      AND (claimed_at IS NULL OR claimed_at < NOW() - $3 * INTERVAL '1 second')
+		    ORDER BY stale DESC, computed_at NULLS FIRST
+		    LIMIT $1
+		    FOR UPDATE SKIP LOCKED
+		)
+		UPDATE recommendation_state s
+		SET stale = FALSE, claimed_at = NOW()
+		FROM due
+		WHERE s.user_id = due.user_id
+		RETURNING s.user_id
+	`, limit, maxAge.Seconds(), claimTimeout.Seconds())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// ReleaseRecommendations gives a claimed user back after a failed refresh
+func (r *sqlRecommendationRepo) ReleaseRecommendations(ctx context.Context, userID int) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE recommendation_state SET stale = TRUE, claimed_at = NULL WHERE user_id = $1`, userID)
+	return err
+}
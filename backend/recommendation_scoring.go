@@ -0,0 +1,616 @@
+package main
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Matching weights, thresholds and keyword taxonomies are data, not code.
+// config/scoring.json holds the defaults; SCORING_CONFIG_FILE can point at a
+// file layered on top of it, and the "default" row of the scoring_config table
+// is layered on top of both and picked up without a redeploy. Layers merge
+// JSON objects key by key, while lists are replaced wholesale.
+//
+//go:embed config/scoring.json
+var defaultScoringJSON []byte
+
+// scoringConfigTTL is how long a loaded config is used before the DB override
+// is read again
+const scoringConfigTTL = time.Minute
+
+// Scoring modes
+const (
+	// ScoringModeOneSided ranks candidates only by how well they fit the viewer
+	ScoringModeOneSided = "one_sided"
+	// ScoringModeMutual also scores the viewer with the candidate's own
+	// preferences and combines both sides with a geometric mean
+	ScoringModeMutual = "mutual"
+)
+
+// ScoringConfig is the tunable part of the recommendation algorithm
+type ScoringConfig struct {
+	// One of the ScoringMode constants
+	Mode string `json:"mode"`
+	// Candidates below this match percentage (combined in mutual mode) are dropped
+	MinScorePercentage float64 `json:"min_score_percentage"`
+	// Upper bound on returned recommendations, 0 means unlimited
+	MaxResults    int                        `json:"max_results"`
+	Dimensions    map[string]DimensionConfig `json:"dimensions"`
+	Interests     InterestConfig             `json:"interests"`
+	Collaboration CollaborationConfig        `json:"collaboration"`
+	Food          TasteConfig                `json:"food"`
+	Music         TasteConfig                `json:"music"`
+	Location      LocationConfig             `json:"location"`
+}
+
+// DimensionConfig scales one scorer. A dimension contributes
+// raw points * the viewer's match_preferences weight / Divisor.
+type DimensionConfig struct {
+	Divisor  int  `json:"divisor"`
+	Disabled bool `json:"disabled,omitempty"`
+}
+
+// InterestConfig scores two interest lists against each other
+type InterestConfig struct {
+	ExactPoints      int                 `json:"exact_points"`
+	RelatedPoints    int                 `json:"related_points"`
+	OverlapBonus     int                 `json:"overlap_bonus"`
+	OverlapThreshold float64             `json:"overlap_threshold"`
+	SemanticGroups   map[string][]string `json:"semantic_groups"`
+}
+
+// CollaborationConfig scores two free-text collaboration descriptions
+type CollaborationConfig struct {
+	KeywordPoints       int                 `json:"keyword_points"`
+	ComplementaryPoints int                 `json:"complementary_points"`
+	CategoryPoints      int                 `json:"category_points"`
+	Keywords            []string            `json:"keywords"`
+	ComplementaryPairs  map[string][]string `json:"complementary_pairs"`
+	Categories          map[string][]string `json:"categories"`
+}
+
+// TasteConfig scores a single free-text favourite (food, music)
+type TasteConfig struct {
+	ExactPoints int                 `json:"exact_points"`
+	GroupPoints int                 `json:"group_points"`
+	Groups      map[string][]string `json:"groups"`
+}
+
+// LocationConfig scores proximity within the viewer's search radius
+type LocationConfig struct {
+	// Share of the weight given when the viewer has no radius limit
+	UnlimitedRadiusFactor float64 `json:"unlimited_radius_factor"`
+	// Extra points for very close candidates; the first matching entry wins
+	NearbyBonuses []NearbyBonus `json:"nearby_bonuses"`
+}
+
+type NearbyBonus struct {
+	WithinKm float64 `json:"within_km"`
+	Points   int     `json:"points"`
+}
+
+// parseScoringConfig applies the JSON layers in order and validates the result
+func parseScoringConfig(layers ...[]byte) (*ScoringConfig, error) {
+	cfg := &ScoringConfig{}
+	for i, layer := range layers {
+		if len(layer) == 0 {
+			continue
+		}
+		if err := json.Unmarshal(layer, cfg); err != nil {
+			return nil, fmt.Errorf("scoring config layer %d: %w", i, err)
+		}
+	}
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+func (c *ScoringConfig) validate() error {
+	if c.Mode != ScoringModeOneSided && c.Mode != ScoringModeMutual {
+		return fmt.Errorf("scoring config: unknown mode %q", c.Mode)
+	}
+	if c.MinScorePercentage < 0 || c.MinScorePercentage > 100 {
+		return fmt.Errorf("scoring config: min_score_percentage %v out of range", c.MinScorePercentage)
+	}
+	if c.MaxResults < 0 {
+		return fmt.Errorf("scoring config: max_results must not be negative")
+	}
+	for _, name := range scorerOrder {
+		dim, ok := c.Dimensions[name]
+		if !ok {
+			return fmt.Errorf("scoring config: dimension %q missing", name)
+		}
+		if !dim.Disabled && dim.Divisor <= 0 {
+			return fmt.Errorf("scoring config: dimension %q needs a positive divisor", name)
+		}
+	}
+	for name := range c.Dimensions {
+		if _, ok := scorerRegistry[name]; !ok {
+			return fmt.Errorf("scoring config: unknown dimension %q", name)
+		}
+	}
+	return nil
+}
+
+// defaultScoringConfig returns a fresh copy of the built-in configuration
+func defaultScoringConfig() *ScoringConfig {
+	cfg, err := parseScoringConfig(defaultScoringJSON)
+	if err != nil {
+		panic(err)
+	}
+	return cfg
+}
+
+// -----------------------------------------------------------------------------
+// Scorers
+// -----------------------------------------------------------------------------
+
+// ScoringPair is a viewer/candidate pair being rated
+type ScoringPair struct {
+	Viewer    *Profile
+	Candidate *Profile
+	// DistanceKm is between the display locations (displayDistance): the
+	// score and explanation are shown to the viewer
+	DistanceKm float64
+}
+
+// Scorer rates a candidate along one matching dimension
+type Scorer interface {
+	// Dimension is the match_preferences key that weights this scorer
+	Dimension() string
+	// Score returns the dimension's contribution for the given weight
+	Score(pair ScoringPair, weight int) int
+	// Explain is Score together with what the two profiles matched on
+	Explain(pair ScoringPair, weight int) DimensionExplanation
+}
+
+// DimensionExplanation breaks down one dimension of a match. Only the lists
+// that apply to the dimension are filled.
+type DimensionExplanation struct {
+	Dimension     string   `json:"dimension"`
+	Weight        int      `json:"weight"`
+	Points        int      `json:"points"`
+	Shared        []string `json:"shared,omitempty"`        // values both profiles list (interests, food, music)
+	Groups        []string `json:"groups,omitempty"`        // semantic groups, categories, cuisines or genres both sides hit
+	Keywords      []string `json:"keywords,omitempty"`      // collaboration keywords both texts mention
+	Complementary []string `json:"complementary,omitempty"` // complementary roles, as "teach/learn"
+	Distance      string   `json:"distance,omitempty"`      // distance range, see distanceBucket
+}
+
+// addUnique appends v unless list already holds it
+func addUnique(list []string, v string) []string {
+	for _, existing := range list {
+		if existing == v {
+			return list
+		}
+	}
+	return append(list, v)
+}
+
+// ScorerFactory builds a dimension's scorer from the active config
+type ScorerFactory func(cfg *ScoringConfig) Scorer
+
+var (
+	scorerRegistry = map[string]ScorerFactory{}
+	scorerOrder    []string
+)
+
+// registerScorer makes a dimension available to the recommendation engine.
+// Every registered dimension must have an entry in the config.
+func registerScorer(dimension string, factory ScorerFactory) {
+	if _, dup := scorerRegistry[dimension]; dup {
+		panic("scorer registered twice: " + dimension)
+	}
+	scorerRegistry[dimension] = factory
+	scorerOrder = append(scorerOrder, dimension)
+}
+
+func init() {
+	registerScorer("analog_passions", func(cfg *ScoringConfig) Scorer {
+		return interestScorer{dimension: "analog_passions", divisor: cfg.Dimensions["analog_passions"].Divisor, cfg: cfg.Interests,
+			field: func(p *Profile) []string { return p.AnalogPassions }}
+	})
+	registerScorer("digital_delights", func(cfg *ScoringConfig) Scorer {
+		return interestScorer{dimension: "digital_delights", divisor: cfg.Dimensions["digital_delights"].Divisor, cfg: cfg.Interests,
+			field: func(p *Profile) []string { return p.DigitalDelights }}
+	})
+	registerScorer("collaboration_interests", func(cfg *ScoringConfig) Scorer {
+		return collaborationScorer{divisor: cfg.Dimensions["collaboration_interests"].Divisor, cfg: cfg.Collaboration}
+	})
+	registerScorer("favorite_food", func(cfg *ScoringConfig) Scorer {
+		return tasteScorer{dimension: "favorite_food", divisor: cfg.Dimensions["favorite_food"].Divisor, cfg: cfg.Food,
+			field: func(p *Profile) string { return p.FavoriteFood }}
+	})
+	registerScorer("favorite_music", func(cfg *ScoringConfig) Scorer {
+		return tasteScorer{dimension: "favorite_music", divisor: cfg.Dimensions["favorite_music"].Divisor, cfg: cfg.Music,
+			field: func(p *Profile) string { return p.FavoriteMusic }}
+	})
+	registerScorer("location", func(cfg *ScoringConfig) Scorer {
+		return locationScorer{divisor: cfg.Dimensions["location"].Divisor, cfg: cfg.Location}
+	})
+}
+
+type interestScorer struct {
+	dimension string
+	divisor   int
+	cfg       InterestConfig
+	field     func(*Profile) []string
+}
+
+func (s interestScorer) Dimension() string { return s.dimension }
+
+func (s interestScorer) Score(pair ScoringPair, weight int) int {
+	return s.cfg.score(s.field(pair.Viewer), s.field(pair.Candidate), nil) * weight / s.divisor
+}
+
+func (s interestScorer) Explain(pair ScoringPair, weight int) DimensionExplanation {
+	e := DimensionExplanation{Dimension: s.dimension, Weight: weight}
+	e.Points = s.cfg.score(s.field(pair.Viewer), s.field(pair.Candidate), &e) * weight / s.divisor
+	return e
+}
+
+// score counts exact matches, matches within the same semantic group and a
+// bonus for a large overlap. If e is not nil the matches are recorded in it.
+func (c InterestConfig) score(userInterests, candidateInterests []string, e *DimensionExplanation) int {
+	if len(userInterests) == 0 || len(candidateInterests) == 0 {
+		return 0
+	}
+	userSet := make(map[string]bool)
+	for _, interest := range userInterests {
+		userSet[strings.ToLower(interest)] = true
+	}
+	exactMatches := 0
+	partialMatches := 0
+	for _, interest := range candidateInterests {
+		if userSet[strings.ToLower(interest)] {
+			exactMatches++
+			if e != nil {
+				e.Shared = addUnique(e.Shared, strings.ToLower(interest))
+			}
+		}
+	}
+	for _, userInterest := range userInterests {
+		userLower := strings.ToLower(userInterest)
+		for _, candidateInterest := range candidateInterests {
+			candidateLower := strings.ToLower(candidateInterest)
+			if userLower == candidateLower {
+				continue
+			}
+			for name, group := range c.SemanticGroups {
+				if containsAny(userLower, group) && containsAny(candidateLower, group) {
+					partialMatches++
+					if e != nil {
+						e.Groups = addUnique(e.Groups, name)
+					}
+					break
+				}
+			}
+		}
+	}
+	score := exactMatches*c.ExactPoints + partialMatches*c.RelatedPoints
+	totalInterests := len(userInterests) + len(candidateInterests)
+	if totalInterests > 0 {
+		overlapRatio := float64(exactMatches*2) / float64(totalInterests)
+		if overlapRatio > c.OverlapThreshold {
+			score += c.OverlapBonus
+		}
+	}
+	return score
+}
+
+type collaborationScorer struct {
+	divisor int
+	cfg     CollaborationConfig
+}
+
+func (s collaborationScorer) Dimension() string { return "collaboration_interests" }
+
+func (s collaborationScorer) Score(pair ScoringPair, weight int) int {
+	return s.cfg.score(pair.Viewer.CrossPollination, pair.Candidate.CrossPollination, nil) * weight / s.divisor
+}
+
+func (s collaborationScorer) Explain(pair ScoringPair, weight int) DimensionExplanation {
+	e := DimensionExplanation{Dimension: s.Dimension(), Weight: weight}
+	e.Points = s.cfg.score(pair.Viewer.CrossPollination, pair.Candidate.CrossPollination, &e) * weight / s.divisor
+	return e
+}
+
+// score rewards shared keywords, complementary roles (teach/learn) and
+// overlapping broad categories. If e is not nil the matches are recorded in it.
+func (c CollaborationConfig) score(a, b string, e *DimensionExplanation) int {
+	a = strings.ToLower(a)
+	b = strings.ToLower(b)
+	score := 0
+	for _, kw := range c.Keywords {
+		if strings.Contains(a, kw) && strings.Contains(b, kw) {
+			score += c.KeywordPoints
+			if e != nil {
+				e.Keywords = addUnique(e.Keywords, kw)
+			}
+		}
+	}
+	for primary, related := range c.ComplementaryPairs {
+		if strings.Contains(a, primary) {
+			for _, rel := range related {
+				if strings.Contains(b, rel) {
+					score += c.ComplementaryPoints
+					if e != nil {
+						e.Complementary = addUnique(e.Complementary, primary+"/"+rel)
+					}
+				}
+			}
+		}
+		if strings.Contains(b, primary) {
+			for _, rel := range related {
+				if strings.Contains(a, rel) {
+					score += c.ComplementaryPoints
+					if e != nil {
+						e.Complementary = addUnique(e.Complementary, primary+"/"+rel)
+					}
+				}
+			}
+		}
+	}
+	for name, words := range c.Categories {
+		if containsAny(a, words) && containsAny(b, words) {
+			score += c.CategoryPoints
+			if e != nil {
+				e.Groups = addUnique(e.Groups, name)
+			}
+		}
+	}
+	return score
+}
+
+type tasteScorer struct {
+	dimension string
+	divisor   int
+	cfg       TasteConfig
+	field     func(*Profile) string
+}
+
+func (s tasteScorer) Dimension() string { return s.dimension }
+
+func (s tasteScorer) Score(pair ScoringPair, weight int) int {
+	return s.cfg.score(s.field(pair.Viewer), s.field(pair.Candidate), nil) * weight / s.divisor
+}
+
+func (s tasteScorer) Explain(pair ScoringPair, weight int) DimensionExplanation {
+	e := DimensionExplanation{Dimension: s.dimension, Weight: weight}
+	e.Points = s.cfg.score(s.field(pair.Viewer), s.field(pair.Candidate), &e) * weight / s.divisor
+	return e
+}
+
+// score gives full points for the same favourite and fewer for one in the
+// same group (cuisine, genre). If e is not nil the match is recorded in it.
+func (c TasteConfig) score(user, candidate string, e *DimensionExplanation) int {
+	if user == "" || candidate == "" {
+		return 0
+	}
+	if strings.EqualFold(user, candidate) {
+		if e != nil {
+			e.Shared = []string{strings.ToLower(user)}
+		}
+		return c.ExactPoints
+	}
+	userLower := strings.ToLower(user)
+	candidateLower := strings.ToLower(candidate)
+	for name, group := range c.Groups {
+		if containsAny(userLower, group) && containsAny(candidateLower, group) {
+			if e != nil {
+				e.Groups = []string{name}
+			}
+			return c.GroupPoints
+		}
+	}
+	return 0
+}
+
+type locationScorer struct {
+	divisor int
+	cfg     LocationConfig
+}
+
+func (s locationScorer) Dimension() string { return "location" }
+
+func (s locationScorer) Score(pair ScoringPair, weight int) int {
+	return s.cfg.score(pair.DistanceKm, pair.Viewer.MaxRadiusKm, weight) / s.divisor
+}
+
+func (s locationScorer) Explain(pair ScoringPair, weight int) DimensionExplanation {
+	return DimensionExplanation{Dimension: s.Dimension(), Weight: weight, Points: s.Score(pair, weight), Distance: distanceBucket(pair.DistanceKm)}
+}
+
+// score scales the weight by how far inside the viewer's radius the
+// candidate is, plus a bonus for very close candidates. Past the radius check
+// only the bucketed distance is used: the weight and radius are the viewer's
+// own, and scaling the distance by them would tell more than its range.
+func (c LocationConfig) score(distance float64, maxRadiusKm int, locationWeight int) int {
+	if maxRadiusKm > 0 && distance > float64(maxRadiusKm) {
+		return 0
+	}
+	if maxRadiusKm == 0 {
+		return int(float64(locationWeight) * c.UnlimitedRadiusFactor)
+	}
+	distance = math.Min(bucketDistance(distance), float64(maxRadiusKm))
+	proximityRatio := 1.0 - (distance / float64(maxRadiusKm))
+	proximityScore := int(proximityRatio * float64(locationWeight))
+	bonus := 0
+	for _, b := range c.NearbyBonuses {
+		if distance <= b.WithinKm {
+			bonus = b.Points
+			break
+		}
+	}
+	if locationWeight == 0 && bonus > 0 {
+		return bonus
+	}
+	return proximityScore + bonus
+}
+
+func containsAny(s string, words []string) bool {
+	for _, w := range words {
+		if strings.Contains(s, w) {
+			return true
+		}
+	}
+	return false
+}
+
+// -----------------------------------------------------------------------------
+// Scoring model
+// -----------------------------------------------------------------------------
+
+// scoringModel is a config together with the scorers built from it
+type scoringModel struct {
+	Config  *ScoringConfig
+	Scorers []Scorer
+}
+
+func newScoringModel(cfg *ScoringConfig) *scoringModel {
+	m := &scoringModel{Config: cfg}
+	for _, name := range scorerOrder {
+		if cfg.Dimensions[name].Disabled {
+			continue
+		}
+		m.Scorers = append(m.Scorers, scorerRegistry[name](cfg))
+	}
+	return m
+}
+
+// Score sums every enabled dimension the viewer gave a positive weight
+func (m *scoringModel) Score(pair ScoringPair, prefs map[string]int) int {
+	score := 0
+	for _, s := range m.Scorers {
+		weight := prefs[s.Dimension()]
+		if weight <= 0 {
+			continue
+		}
+		score += s.Score(pair, weight)
+	}
+	return score
+}
+
+// Rate scores the pair from the viewer's side, returning the score and its
+// share of the viewer's maximum possible score (the sum of their weights)
+func (m *scoringModel) Rate(pair ScoringPair) (int, float64) {
+	score := m.Score(pair, pair.Viewer.MatchPreferences)
+	maxPossible := 0
+	for _, weight := range pair.Viewer.MatchPreferences {
+		maxPossible += weight
+	}
+	if maxPossible <= 0 {
+		maxPossible = 1 // Avoid division by zero
+	}
+	percentage := float64(score) / float64(maxPossible) * 100
+	if percentage > 100 {
+		percentage = 100
+	}
+	return score, percentage
+}
+
+// Explain breaks the viewer's side of the match down per dimension. Every
+// enabled dimension is listed so shared interests show up even where the
+// viewer gave no weight; such dimensions earn no points.
+func (m *scoringModel) Explain(pair ScoringPair) []DimensionExplanation {
+	out := make([]DimensionExplanation, 0, len(m.Scorers))
+	for _, s := range m.Scorers {
+		weight := pair.Viewer.MatchPreferences[s.Dimension()]
+		if weight < 0 {
+			weight = 0
+		}
+		e := s.Explain(pair, weight)
+		if weight == 0 {
+			e.Points = 0
+		}
+		out = append(out, e)
+	}
+	return out
+}
+
+// Match rates the pair according to the configured mode. In mutual mode the
+// candidate rates the viewer with their own weights and radius, and both
+// sides are combined with a geometric mean, so a match that only one side
+// would want ends up near zero.
+func (m *scoringModel) Match(pair ScoringPair) (int, float64) {
+	score, percentage := m.Rate(pair)
+	if m.Config.Mode != ScoringModeMutual {
+		return score, percentage
+	}
+	reverse := ScoringPair{Viewer: pair.Candidate, Candidate: pair.Viewer, DistanceKm: pair.DistanceKm}
+	theirScore, theirPercentage := m.Rate(reverse)
+	return int(math.Round(math.Sqrt(float64(score * theirScore)))), math.Sqrt(percentage * theirPercentage)
+}
+
+// scoringConfigStore caches the active scoring model and refreshes the DB
+// override every scoringConfigTTL
+type scoringConfigStore struct {
+	mu       sync.Mutex
+	ttl      time.Duration
+	base     [][]byte
+	model    *scoringModel
+	loadedAt time.Time
+}
+
+var scoringConfigs = &scoringConfigStore{ttl: scoringConfigTTL}
+
+// baseLayers returns the embedded defaults plus SCORING_CONFIG_FILE, if set
+func (s *scoringConfigStore) baseLayers() [][]byte {
+	if s.base != nil {
+		return s.base
+	}
+	s.base = [][]byte{defaultScoringJSON}
+	if path := os.Getenv("SCORING_CONFIG_FILE"); path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			log.Printf("scoring config: cannot read %s: %v", path, err)
+		} else {
+			s.base = append(s.base, data)
+		}
+	}
+	return s.base
+}
+
+// get returns the current model. A broken override is logged and the last
+// good model (or the defaults) is kept, so bad tuning never takes
+// recommendations down.
+func (s *scoringConfigStore) get(ctx context.Context, repo RecommendationRepository) *scoringModel {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.model != nil && time.Since(s.loadedAt) < s.ttl {
+		return s.model
+	}
+
+	layers := s.baseLayers()
+	override, err := repo.GetScoringConfig(ctx)
+	if err != nil {
+		log.Printf("scoring config: loading override failed: %v", err)
+	} else if len(override) > 0 {
+		layers = append(layers[:len(layers):len(layers)], override)
+	}
+
+	cfg, err := parseScoringConfig(layers...)
+	if err != nil {
+		log.Printf("%v, keeping the previous config", err)
+		if s.model == nil {
+			if cfg, err = parseScoringConfig(s.baseLayers()...); err != nil {
+				cfg = defaultScoringConfig()
+			}
+			s.model = newScoringModel(cfg)
+		}
+	} else {
+		s.model = newScoringModel(cfg)
+	}
+	s.loadedAt = time.Now()
+	return s.model
+}
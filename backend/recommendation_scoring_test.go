@@ -0,0 +1,283 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestDefaultScoringConfig(t *testing.T) {
+	cfg := defaultScoringConfig()
+	if cfg.MinScorePercentage != 25 || cfg.MaxResults != 0 {
+		t.Fatalf("unexpected thresholds: min=%v max=%d", cfg.MinScorePercentage, cfg.MaxResults)
+	}
+	model := newScoringModel(cfg)
+	if len(model.Scorers) != len(scorerOrder) {
+		t.Fatalf("expected %d scorers, got %d", len(scorerOrder), len(model.Scorers))
+	}
+}
+
+func TestParseScoringConfigLayers(t *testing.T) {
+	t.Run("override merges into defaults", func(t *testing.T) {
+		cfg, err := parseScoringConfig(defaultScoringJSON, []byte(`{"max_results": 20, "dimensions": {"location": {"divisor": 2}}}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if cfg.MaxResults != 20 {
+			t.Fatalf("expected max_results 20, got %d", cfg.MaxResults)
+		}
+		if cfg.Dimensions["location"].Divisor != 2 || cfg.Dimensions["analog_passions"].Divisor != 3 {
+			t.Fatalf("unexpected divisors: %+v", cfg.Dimensions)
+		}
+		if len(cfg.Music.Groups) == 0 {
+			t.Fatal("expected taxonomies from the defaults to survive the override")
+		}
+	})
+
+	t.Run("invalid configs are rejected", func(t *testing.T) {
+		bad := []string{
+			`{"dimensions": {"location": {"divisor": 0}}}`,
+			`{"dimensions": {"astrology": {"divisor": 1}}}`,
+			`{"min_score_percentage": 120}`,
+			`{"max_results": "ten"}`,
+			`{"mode": "both"}`,
+		}
+		for _, override := range bad {
+			if _, err := parseScoringConfig(defaultScoringJSON, []byte(override)); err == nil {
+				t.Errorf("expected %s to be rejected", override)
+			}
+		}
+	})
+
+	t.Run("disabled dimension needs no divisor", func(t *testing.T) {
+		cfg, err := parseScoringConfig(defaultScoringJSON, []byte(`{"dimensions": {"favorite_music": {"divisor": 0, "disabled": true}}}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, s := range newScoringModel(cfg).Scorers {
+			if s.Dimension() == "favorite_music" {
+				t.Fatal("disabled scorer was built")
+			}
+		}
+	})
+}
+
+func scoreDimension(t *testing.T, dimension string, viewer, candidate Profile, weight int) int {
+	t.Helper()
+	scorer := scorerRegistry[dimension](defaultScoringConfig())
+	return scorer.Score(ScoringPair{Viewer: &viewer, Candidate: &candidate}, weight)
+}
+
+func TestInterestScorer(t *testing.T) {
+	viewer := Profile{AnalogPassions: []string{"Piano", "hiking"}}
+
+	// one exact match (3) and one same-group match, piano/guitar (1)
+	got := scoreDimension(t, "analog_passions", viewer, Profile{AnalogPassions: []string{"piano", "guitar"}}, 3)
+	if got != 4 {
+		t.Fatalf("expected 4, got %d", got)
+	}
+
+	// identical lists also earn the overlap bonus
+	got = scoreDimension(t, "analog_passions", viewer, Profile{AnalogPassions: []string{"piano", "hiking"}}, 3)
+	if got != 11 {
+		t.Fatalf("expected 11, got %d", got)
+	}
+
+	if got := scoreDimension(t, "digital_delights", viewer, Profile{DigitalDelights: []string{"coding"}}, 3); got != 0 {
+		t.Fatalf("expected 0 for empty viewer list, got %d", got)
+	}
+}
+
+func TestCollaborationScorer(t *testing.T) {
+	// shared keywords d&d and group (2x15) plus the social category (5)
+	got := scoreDimension(t, "collaboration_interests",
+		Profile{CrossPollination: "Looking for D&D group"},
+		Profile{CrossPollination: "Running a D&D group"}, 15)
+	if got != 35 {
+		t.Fatalf("expected 35, got %d", got)
+	}
+
+	// teach complements learn and beginner (2x10) plus the educational category (5)
+	got = scoreDimension(t, "collaboration_interests",
+		Profile{CrossPollination: "I want to teach pottery"},
+		Profile{CrossPollination: "Beginner wanting to learn"}, 15)
+	if got != 25 {
+		t.Fatalf("expected 25, got %d", got)
+	}
+}
+
+func TestTasteScorers(t *testing.T) {
+	cases := []struct {
+		dimension string
+		a, b      Profile
+		want      int
+	}{
+		{"favorite_food", Profile{FavoriteFood: "Pizza"}, Profile{FavoriteFood: "pizza"}, 10},
+		{"favorite_food", Profile{FavoriteFood: "Thai"}, Profile{FavoriteFood: "Japanese"}, 6},
+		{"favorite_food", Profile{FavoriteFood: "Sushi"}, Profile{FavoriteFood: "Tacos"}, 0},
+		{"favorite_music", Profile{FavoriteMusic: "Jazz"}, Profile{FavoriteMusic: "jazz"}, 10},
+		{"favorite_music", Profile{FavoriteMusic: "Techno"}, Profile{FavoriteMusic: "Ambient"}, 6},
+		{"favorite_music", Profile{FavoriteMusic: ""}, Profile{FavoriteMusic: "Jazz"}, 0},
+	}
+	for _, tc := range cases {
+		if got := scoreDimension(t, tc.dimension, tc.a, tc.b, 10); got != tc.want {
+			t.Errorf("%s %+v vs %+v: expected %d, got %d", tc.dimension, tc.a, tc.b, tc.want, got)
+		}
+	}
+}
+
+func TestScoringModelWeights(t *testing.T) {
+	viewer := Profile{FavoriteFood: "Pizza", FavoriteMusic: "Jazz"}
+	candidate := Profile{FavoriteFood: "Pizza", FavoriteMusic: "Jazz"}
+	pair := ScoringPair{Viewer: &viewer, Candidate: &candidate}
+	model := newScoringModel(defaultScoringConfig())
+
+	if got := model.Score(pair, map[string]int{"favorite_food": 10, "favorite_music": 10}); got != 20 {
+		t.Fatalf("expected 20, got %d", got)
+	}
+	if got := model.Score(pair, map[string]int{"favorite_food": 10, "favorite_music": 0}); got != 10 {
+		t.Fatalf("expected zero-weight dimension to be skipped, got %d", got)
+	}
+}
+
+func TestScoringModelMutual(t *testing.T) {
+	// the viewer only cares about food, the candidate only about music
+	viewer := Profile{FavoriteFood: "Pizza", FavoriteMusic: "Jazz",
+		MatchPreferences: map[string]int{"favorite_food": 10}}
+	candidate := Profile{FavoriteFood: "Pizza", FavoriteMusic: "Techno",
+		MatchPreferences: map[string]int{"favorite_music": 10}}
+	pair := ScoringPair{Viewer: &viewer, Candidate: &candidate}
+
+	cfg := defaultScoringConfig()
+	cfg.Mode = ScoringModeOneSided
+	score, percentage := newScoringModel(cfg).Match(pair)
+	if score != 10 || percentage != 100 {
+		t.Fatalf("one-sided: expected 10/100%%, got %d/%.1f%%", score, percentage)
+	}
+
+	cfg.Mode = ScoringModeMutual
+	score, percentage = newScoringModel(cfg).Match(pair)
+	if score != 0 || percentage != 0 {
+		t.Fatalf("mutual: expected a one-sided match to score 0, got %d/%.1f%%", score, percentage)
+	}
+
+	// the candidate rates the viewer at 60%, so the geometric mean is sqrt(100*60)
+	candidate.FavoriteMusic = "Blues"
+	score, percentage = newScoringModel(cfg).Match(pair)
+	if score != 8 || math.Abs(percentage-math.Sqrt(6000)) > 1e-9 {
+		t.Fatalf("mutual: expected 8/%.2f%%, got %d/%.2f%%", math.Sqrt(6000), score, percentage)
+	}
+}
+
+type stubScoringRepo struct {
+	RecommendationRepository
+	override []byte
+	err      error
+}
+
+func (r stubScoringRepo) GetScoringConfig(ctx context.Context) ([]byte, error) {
+	return r.override, r.err
+}
+
+func TestScoringConfigStore(t *testing.T) {
+	t.Setenv("SCORING_CONFIG_FILE", "")
+
+	store := &scoringConfigStore{ttl: 0}
+	model := store.get(context.Background(), stubScoringRepo{override: []byte(`{"max_results": 3}`)})
+	if model.Config.MaxResults != 3 {
+		t.Fatalf("expected override to apply, got %d", model.Config.MaxResults)
+	}
+
+	// a broken override keeps the last good model
+	model = store.get(context.Background(), stubScoringRepo{override: []byte(`{"dimensions": {"location": {"divisor": -1}}}`)})
+	if model.Config.MaxResults != 3 {
+		t.Fatalf("expected last good config, got max_results %d", model.Config.MaxResults)
+	}
+
+	// with nothing loaded yet, failures fall back to the defaults
+	fresh := &scoringConfigStore{ttl: 0}
+	model = fresh.get(context.Background(), stubScoringRepo{err: errors.New("db down")})
+	if model.Config.MaxResults != 0 || model.Config.Mode != ScoringModeMutual {
+		t.Fatalf("expected defaults, got %+v", model.Config)
+	}
+}
+
+func TestScoringModelExplain(t *testing.T) {
+	viewer := Profile{
+		AnalogPassions:   []string{"Pottery", "guitar"},
+		CrossPollination: "I want to teach pottery",
+		FavoriteMusic:    "Jazz",
+		FavoriteFood:     "Thai",
+		MaxRadiusKm:      50,
+		MatchPreferences: map[string]int{"analog_passions": 3, "collaboration_interests": 15, "favorite_music": 10, "location": 10},
+	}
+	candidate := Profile{
+		AnalogPassions:   []string{"pottery", "piano"},
+		CrossPollination: "Beginner wanting to learn",
+		FavoriteMusic:    "jazz",
+		FavoriteFood:     "Japanese",
+	}
+	pair := ScoringPair{Viewer: &viewer, Candidate: &candidate, DistanceKm: 12.34}
+	model := newScoringModel(defaultScoringConfig())
+
+	dims := map[string]DimensionExplanation{}
+	total := 0
+	for _, e := range model.Explain(pair) {
+		dims[e.Dimension] = e
+		total += e.Points
+	}
+	if len(dims) != len(scorerOrder) {
+		t.Fatalf("expected every dimension, got %v", dims)
+	}
+	if total != model.Score(pair, viewer.MatchPreferences) {
+		t.Fatalf("explained points %d do not add up to the score %d", total, model.Score(pair, viewer.MatchPreferences))
+	}
+
+	if e := dims["analog_passions"]; len(e.Shared) != 1 || e.Shared[0] != "pottery" || len(e.Groups) != 1 || e.Groups[0] != "music" {
+		t.Errorf("unexpected interest explanation %+v", e)
+	}
+	if e := dims["collaboration_interests"]; len(e.Complementary) != 2 || len(e.Groups) != 1 || e.Groups[0] != "educational" {
+		t.Errorf("unexpected collaboration explanation %+v", e)
+	}
+	if e := dims["favorite_music"]; len(e.Shared) != 1 || e.Shared[0] != "jazz" || e.Points != 10 {
+		t.Errorf("unexpected music explanation %+v", e)
+	}
+	// the viewer gave food no weight: the shared cuisine is listed but earns nothing
+	if e := dims["favorite_food"]; len(e.Groups) != 1 || e.Groups[0] != "asian" || e.Points != 0 {
+		t.Errorf("unexpected food explanation %+v", e)
+	}
+	if e := dims["location"]; e.Distance != "5–15 km" || e.Points == 0 {
+		t.Errorf("unexpected location explanation %+v", e)
+	}
+}
+
+func TestLocationPointsFollowBucket(t *testing.T) {
+	model := newScoringModel(defaultScoringConfig())
+	// a huge weight and an odd radius would turn any difference in the exact
+	// distance into a difference in points
+	viewer := Profile{MaxRadiusKm: 97, MatchPreferences: map[string]int{"location": 1_000_000}}
+
+	points := func(km float64) int {
+		for _, e := range model.Explain(ScoringPair{Viewer: &viewer, Candidate: &Profile{}, DistanceKm: km}) {
+			if e.Dimension == "location" {
+				return e.Points
+			}
+		}
+		t.Fatal("no location dimension")
+		return 0
+	}
+
+	if a, b := points(15.2), points(49.9); a != b {
+		t.Errorf("distances in one bucket score %d and %d", a, b)
+	}
+	if a, b := points(0), points(4.9); a != b {
+		t.Errorf("distances in one bucket score %d and %d", a, b)
+	}
+	if points(4.9) <= points(15.2) {
+		t.Error("closer buckets should score higher")
+	}
+	if points(97.5) != 0 {
+		t.Error("candidates beyond the radius should score nothing")
+	}
+}
@@ -3,12 +3,34 @@ package main
 import (
 	"context"
 	"database/sql"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"math"
 	"sort"
+	"strconv"
 	"strings"
+	"time"
+
+	"gitea.kood.tech/petrkubec/match-me/backend/events"
+)
+
+var (
+	ErrIncompleteProfile = errors.New("incomplete_profile")
+	ErrInvalidCursor     = errors.New("invalid_cursor")
+	ErrCursorExpired     = errors.New("cursor_expired")
 )
-// RecommendationResult represents a user recommendation with calculated score
+
+const (
+	// recommendationSnapshotTTL is how long a ranked feed can be paged through
+	// before the client has to start over without a cursor
+	recommendationSnapshotTTL = 30 * time.Minute
+
+	defaultRecommendationPageSize = 10
+	maxRecommendationPageSize     = 50
+)
+// RecommendationResult represents a user recommendation with calculated score.
+// Distance is between the display locations (displayDistance).
 type RecommendationResult struct {
 	UserID          int     `json:"user_id"`
 	Score           int     `json:"score"`
@@ -19,9 +41,23 @@ type RecommendationResult struct {
 type RecommendationService interface {
 	GetRecommendedUserIDs(ctx context.Context, userID int) ([]int, error)
 	GetRecommendationsWithScores(ctx context.Context, userID int) ([]RecommendationResult, error)
+	GetRecommendationPage(ctx context.Context, userID int, cursor string, limit int) (*RecommendationPage, error)
 	IsCurrentlyRecommendable(ctx context.Context, me, targetID int) (bool, error)
 	DismissRecommendation(ctx context.Context, userID, dismissedUserID int) error
 	CheckProfileComplete(ctx context.Context, userID int) (bool, error)
+	RefreshRecommendations(ctx context.Context, userID int) ([]RecommendationResult, error)
+	ExplainRecommendation(ctx context.Context, userID, candidateID int) (*RecommendationExplanation, error)
+	ExplainRecommendations(ctx context.Context, userID int, candidateIDs []int) (map[int]*RecommendationExplanation, error)
+}
+
+// RecommendationExplanation says why a candidate is recommended. Dimensions
+// are scored from the viewer's side with the viewer's weights; Score and
+// ScorePercentage are the match as ranked (mutual by default).
+type RecommendationExplanation struct {
+	UserID          int                    `json:"user_id"`
+	Score           int                    `json:"score"`
+	ScorePercentage float64                `json:"score_percentage"`
+	Dimensions      []DimensionExplanation `json:"dimensions"`
 }
 
 type recommendationService struct {
@@ -37,22 +73,60 @@ func (s *recommendationService) CheckProfileComplete(ctx context.Context, userID
 }
 
 func (s *recommendationService) DismissRecommendation(ctx context.Context, userID, dismissedUserID int) error {
-	return s.repo.InsertDismissal(ctx, userID, dismissedUserID)
+	if err := s.repo.InsertDismissal(ctx, userID, dismissedUserID); err != nil {
+		return err
+	}
+	events.Default().PublishDismissal(events.Dismissal{UserID: userID, DismissedUserID: dismissedUserID})
+	return nil
 }
 
 func (s *recommendationService) IsCurrentlyRecommendable(ctx context.Context, me, targetID int) (bool, error) {
-	recs, err := s.GetRecommendedUserIDs(ctx, me)
+	found, cached, err := s.repo.IsCachedRecommendation(ctx, me, targetID)
+	if err != nil || cached {
+		return found, err
+	}
+	results, err := s.RefreshRecommendations(ctx, me)
 	if err != nil {
 		return false, err
 	}
-	for _, id := range recs {
-		if id == targetID {
+	for _, result := range results {
+		if result.UserID == targetID {
 			return true, nil
 		}
 	}
 	return false, nil
 }
 
+// cachedRecommendations reads the materialized list, computing and storing it
+// on the spot the first time
+func (s *recommendationService) cachedRecommendations(ctx context.Context, userID int) ([]RecommendationResult, error) {
+	results, cached, err := s.repo.GetCachedRecommendations(ctx, userID)
+	if err != nil || cached {
+		return results, err
+	}
+	return s.RefreshRecommendations(ctx, userID)
+}
+
+// RefreshRecommendations rescores the user's candidates and replaces the
+// materialized list. Users without a complete profile have their list dropped.
+func (s *recommendationService) RefreshRecommendations(ctx context.Context, userID int) ([]RecommendationResult, error) {
+	complete, err := s.repo.CheckProfileComplete(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !complete {
+		return nil, s.repo.DeleteRecommendations(ctx, userID)
+	}
+	results, err := s.GetRecommendationsWithScores(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.StoreRecommendations(ctx, userID, results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
 // Wrapper for backward compatibility with connections.go
 func isCurrentlyRecommendable(ctx context.Context, db *sql.DB, me, targetID int) (bool, error) {
 	repo := NewRecommendationRepository(db)
@@ -60,15 +134,8 @@ func isCurrentlyRecommendable(ctx context.Context, db *sql.DB, me, targetID int)
 	return svc.IsCurrentlyRecommendable(ctx, me, targetID)
 }
 
-// Wrapper for backward compatibility with users_profiles.go
-func getRecommendedUserIDs(ctx context.Context, db *sql.DB, userID int) ([]int, error) {
-	repo := NewRecommendationRepository(db)
-	svc := NewRecommendationService(repo)
-	return svc.GetRecommendedUserIDs(ctx, userID)
-}
-
 func (s *recommendationService) GetRecommendedUserIDs(ctx context.Context, userID int) ([]int, error) {
-	results, err := s.GetRecommendationsWithScores(ctx, userID)
+	results, err := s.cachedRecommendations(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -80,6 +147,119 @@ func (s *recommendationService) GetRecommendedUserIDs(ctx context.Context, userI
 	return recommendations, nil
 }
 
+// RecommendationPage is one page of a ranked snapshot. The snapshot is frozen
+// when the first page is requested, so later pages never reshuffle.
+type RecommendationPage struct {
+	SnapshotID string
+	Offset     int // position of Results[0] in the snapshot
+	Total      int
+	Results    []RecommendationResult
+}
+
+// Cursor points just past Results[i]
+func (p *RecommendationPage) Cursor(i int) string {
+	return encodeRecommendationCursor(p.SnapshotID, p.Offset+i+1)
+}
+
+// HasNext reports whether the snapshot continues after this page
+func (p *RecommendationPage) HasNext() bool {
+	return p.Offset+len(p.Results) < p.Total
+}
+
+// NextCursor continues after this page, or is empty at the end of the snapshot
+func (p *RecommendationPage) NextCursor() string {
+	if !p.HasNext() {
+		return ""
+	}
+	return p.Cursor(len(p.Results) - 1)
+}
+
+func encodeRecommendationCursor(snapshotID string, offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(snapshotID + ":" + strconv.Itoa(offset)))
+}
+
+func decodeRecommendationCursor(cursor string) (string, int, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return "", 0, ErrInvalidCursor
+	}
+	id, offsetStr, ok := strings.Cut(string(raw), ":")
+	offset, err := strconv.Atoi(offsetStr)
+	if !ok || id == "" || err != nil || offset < 0 {
+		return "", 0, ErrInvalidCursor
+	}
+	return id, offset, nil
+}
+
+// GetRecommendationPage returns up to limit results after cursor. An empty
+// cursor takes the current ranked list and stores it as a new snapshot.
+func (s *recommendationService) GetRecommendationPage(ctx context.Context, userID int, cursor string, limit int) (*RecommendationPage, error) {
+	if limit <= 0 {
+		limit = defaultRecommendationPageSize
+	}
+	if limit > maxRecommendationPageSize {
+		limit = maxRecommendationPageSize
+	}
+
+	var snapshotID string
+	var offset int
+	var results []RecommendationResult
+
+	if cursor == "" {
+		var err error
+		results, err = s.cachedRecommendations(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		if snapshotID, err = randomHex(16); err != nil {
+			return nil, err
+		}
+		raw, err := json.Marshal(results)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.repo.CreateSnapshot(ctx, snapshotID, userID, raw, time.Now().Add(recommendationSnapshotTTL)); err != nil {
+			return nil, err
+		}
+	} else {
+		var err error
+		if snapshotID, offset, err = decodeRecommendationCursor(cursor); err != nil {
+			return nil, err
+		}
+		owner, raw, expiresAt, err := s.repo.GetSnapshot(ctx, snapshotID)
+		if errors.Is(err, ErrNotFound) {
+			// well-formed but gone: expired snapshots are pruned
+			return nil, ErrCursorExpired
+		}
+		if err != nil {
+			return nil, err
+		}
+		if owner != userID {
+			return nil, ErrInvalidCursor
+		}
+		if time.Now().After(expiresAt) {
+			return nil, ErrCursorExpired
+		}
+		if err := json.Unmarshal(raw, &results); err != nil {
+			return nil, err
+		}
+		if offset > len(results) {
+			return nil, ErrInvalidCursor
+		}
+	}
+
+	end := offset + limit
+	if end > len(results) {
+		end = len(results)
+	}
+	return &RecommendationPage{
+		SnapshotID: snapshotID,
+		Offset:     offset,
+		Total:      len(results),
+		Results:    results[offset:end],
+	}, nil
+}
+
 func (s *recommendationService) GetRecommendationsWithScores(ctx context.Context, userID int) ([]RecommendationResult, error) {
 	userProfile, analogPassions, digitalDelights, matchPrefsRaw, err := s.repo.GetUserProfileData(ctx, userID)
 	if err != nil {
@@ -89,275 +269,121 @@ func (s *recommendationService) GetRecommendationsWithScores(ctx context.Context
 	json.Unmarshal(digitalDelights, &userProfile.DigitalDelights)
 	json.Unmarshal(matchPrefsRaw, &userProfile.MatchPreferences)
 
-	var minLat, maxLat, minLon, maxLon *float64
-	if userProfile.MaxRadiusKm > 0 {
-		deltaLat := float64(userProfile.MaxRadiusKm) / 111.32
-		cosLat := math.Cos(userProfile.LocationLat * math.Pi / 180.0)
-		var deltaLon float64
-		if cosLat > 0.001 {
-			deltaLon = float64(userProfile.MaxRadiusKm) / (111.32 * cosLat)
-		} else {
-			deltaLon = 360.0
-		}
-
-		minL := userProfile.LocationLat - deltaLat
-		maxL := userProfile.LocationLat + deltaLat
-		minLo := userProfile.LocationLon - deltaLon
-		maxLo := userProfile.LocationLon + deltaLon
+	area := newGeoSearch(userProfile.LocationLat, userProfile.LocationLon, float64(userProfile.MaxRadiusKm))
 
-		minLat = &minL
-		maxLat = &maxL
-		minLon = &minLo
-		maxLon = &maxLo
-	}
-
-	rows, err := s.repo.GetCandidateProfiles(ctx, userID, minLat, maxLat, minLon, maxLon)
+	rows, err := s.repo.GetCandidateProfiles(ctx, userID, userProfile.LocationLat, userProfile.LocationLon, area)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	type candidateScore struct {
-		UserID   int
-		Score    int
-		Distance float64
-	}
-	var candidates []candidateScore
-
-	maxPossibleScore := 0
-	for _, weight := range userProfile.MatchPreferences {
-		maxPossibleScore += weight
-	}
-	if maxPossibleScore == 0 {
-		maxPossibleScore = 1 // Avoid division by zero
-	}
+	model := scoringConfigs.get(ctx, s.repo)
 
+	var results []RecommendationResult
 	for rows.Next() {
 		var c Profile
-		var analog, digital []byte
-		err := rows.Scan(&c.UserID, &analog, &digital, &c.CrossPollination, &c.FavoriteFood, &c.FavoriteMusic, &c.LocationLat, &c.LocationLon)
+		var analog, digital, prefs []byte
+		err := rows.Scan(&c.UserID, &analog, &digital, &c.CrossPollination, &c.FavoriteFood, &c.FavoriteMusic,
+			&c.LocationLat, &c.LocationLon, &c.MaxRadiusKm, &prefs)
 		if err != nil {
 			continue
 		}
 		json.Unmarshal(analog, &c.AnalogPassions)
 		json.Unmarshal(digital, &c.DigitalDelights)
+		json.Unmarshal(prefs, &c.MatchPreferences)
 
-		distance := haversine(userProfile.LocationLat, userProfile.LocationLon, c.LocationLat, c.LocationLon)
-
-		if userProfile.MaxRadiusKm > 0 && distance > float64(userProfile.MaxRadiusKm) {
+		exact := haversine(userProfile.LocationLat, userProfile.LocationLon, c.LocationLat, c.LocationLon)
+		if userProfile.MaxRadiusKm > 0 && exact > float64(userProfile.MaxRadiusKm) {
 			continue
 		}
 
-		score := 0
-
-		analogScore := calculateInterestScore(userProfile.AnalogPassions, c.AnalogPassions)
-		score += analogScore * userProfile.MatchPreferences["analog_passions"] / 3
-
-		digitalScore := calculateInterestScore(userProfile.DigitalDelights, c.DigitalDelights)
-		score += digitalScore * userProfile.MatchPreferences["digital_delights"] / 3
-
-		crossScore := crossPollinationScore(userProfile.CrossPollination, c.CrossPollination)
-		score += crossScore * userProfile.MatchPreferences["collaboration_interests"] / 15
-
-		foodScore := calculateFoodScore(userProfile.FavoriteFood, c.FavoriteFood)
-		score += foodScore * userProfile.MatchPreferences["favorite_food"] / 10
-
-		musicScore := calculateMusicScore(userProfile.FavoriteMusic, c.FavoriteMusic)
-		score += musicScore * userProfile.MatchPreferences["favorite_music"] / 10
-
-		if userProfile.MatchPreferences["location"] > 0 {
-			locationScore := calculateLocationScore(
-				userProfile.LocationLat, userProfile.LocationLon,
-				c.LocationLat, c.LocationLon,
-				userProfile.MaxRadiusKm, userProfile.MatchPreferences["location"])
-			score += locationScore
-		}
-
-		percentage := (float64(score) / float64(maxPossibleScore)) * 100
-		if percentage > 100 {
-			percentage = 100
-		}
-
-		if percentage >= 25.0 {
-			candidates = append(candidates, candidateScore{UserID: c.UserID, Score: score, Distance: distance})
-		}
-	}
-
-	sort.Slice(candidates, func(i, j int) bool {
-		return candidates[i].Score > candidates[j].Score
-	})
-
-	var results []RecommendationResult
-	for i := 0; i < len(candidates) && i < 10; i++ {
-		candidate := candidates[i]
-		percentage := (float64(candidate.Score) / float64(maxPossibleScore)) * 100
-		if percentage > 100 {
-			percentage = 100
+		// Past the radius check only the display distance is used, since it
+		// reaches the viewer through the score and the distance range
+		distance := displayDistance(userID, userProfile.LocationLat, userProfile.LocationLon, c.UserID, c.LocationLat, c.LocationLon)
+		score, percentage := model.Match(ScoringPair{Viewer: &userProfile, Candidate: &c, DistanceKm: distance})
+		if percentage < model.Config.MinScorePercentage {
+			continue
 		}
 
 		result := RecommendationResult{
-			UserID:          candidate.UserID,
-			Score:           candidate.Score,
+			UserID:          c.UserID,
+			Score:           score,
 			ScorePercentage: percentage,
 		}
-		if candidate.Distance > 0 {
-			result.Distance = candidate.Distance
+		if distance > 0 {
+			result.Distance = distance
 		}
 		results = append(results, result)
 	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].ScorePercentage != results[j].ScorePercentage {
+			return results[i].ScorePercentage > results[j].ScorePercentage
+		}
+		return results[i].Score > results[j].Score
+	})
+	if limit := model.Config.MaxResults; limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
 	return results, nil
 }
 
-// -----------------------------------------------------------------------------
-// Scoring algorithms migrated from helper.go
-// -----------------------------------------------------------------------------
-
-func calculateInterestScore(userInterests, candidateInterests []string) int {
-	if len(userInterests) == 0 || len(candidateInterests) == 0 {
-		return 0
-	}
-	userSet := make(map[string]bool)
-	for _, interest := range userInterests {
-		userSet[strings.ToLower(interest)] = true
-	}
-	exactMatches := 0
-	partialMatches := 0
-	for _, interest := range candidateInterests {
-		if userSet[strings.ToLower(interest)] {
-			exactMatches++
-		}
+// ExplainRecommendation explains one candidate, who must currently be
+// recommended to the user
+func (s *recommendationService) ExplainRecommendation(ctx context.Context, userID, candidateID int) (*RecommendationExplanation, error) {
+	ok, err := s.IsCurrentlyRecommendable(ctx, userID, candidateID)
+	if err != nil {
+		return nil, err
 	}
-	semanticGroups := map[string][]string{
-		"music":   {"music", "singing", "piano", "guitar", "drums", "composition", "recording"},
-		"visual":  {"art", "painting", "drawing", "photography", "design", "graphics"},
-		"tech":    {"programming", "coding", "software", "hardware", "electronics", "robotics"},
-		"crafts":  {"knitting", "sewing", "woodworking", "pottery", "jewelry", "crafting"},
-		"games":   {"gaming", "boardgames", "videogames", "rpg", "strategy", "puzzle"},
-		"outdoor": {"hiking", "cycling", "running", "camping", "climbing", "nature"},
-		"food":    {"cooking", "baking", "brewing", "wine", "coffee", "culinary"},
-		"fitness": {"yoga", "martial arts", "gym", "sports", "dance", "fitness"},
+	if !ok {
+		return nil, ErrNotFound
 	}
-	for _, userInterest := range userInterests {
-		userLower := strings.ToLower(userInterest)
-		for _, candidateInterest := range candidateInterests {
-			candidateLower := strings.ToLower(candidateInterest)
-			if userLower == candidateLower {
-				continue
-			}
-			for _, group := range semanticGroups {
-				userInGroup := false
-				candidateInGroup := false
-				for _, groupWord := range group {
-					if strings.Contains(userLower, groupWord) {
-						userInGroup = true
-					}
-					if strings.Contains(candidateLower, groupWord) {
-						candidateInGroup = true
-					}
-				}
-				if userInGroup && candidateInGroup {
-					partialMatches++
-					break
-				}
-			}
-		}
+	explanations, err := s.ExplainRecommendations(ctx, userID, []int{candidateID})
+	if err != nil {
+		return nil, err
 	}
-	score := exactMatches*3 + partialMatches*1
-	totalInterests := len(userInterests) + len(candidateInterests)
-	if totalInterests > 0 {
-		overlapRatio := float64(exactMatches*2) / float64(totalInterests)
-		if overlapRatio > 0.5 {
-			score += 5
-		}
+	if explanations[candidateID] == nil {
+		return nil, ErrNotFound
 	}
-	return score
+	return explanations[candidateID], nil
 }
 
-func crossPollinationScore(a, b string) int {
-	a = strings.ToLower(a)
-	b = strings.ToLower(b)
-	exactKeywords := []string{"d&d", "dungeons and dragons", "knitting", "blacksmithing", "discord",
-		"teaching", "learning", "collaborative", "group", "team", "workshop", "meetup"}
-	score := 0
-	for _, kw := range exactKeywords {
-		if strings.Contains(a, kw) && strings.Contains(b, kw) {
-			score += 15
-		}
-	}
-	complementaryPairs := map[string][]string{
-		"teach":  {"learn", "student", "beginner"},
-		"mentor": {"mentee", "guidance", "help"},
-		"code":   {"programming", "development", "software"},
-		"design": {"ui", "ux", "graphic", "visual"},
-		"music":  {"band", "jam", "collaborate", "duet"},
-		"art":    {"paint", "draw", "create", "studio"},
-		"craft":  {"handmade", "diy", "workshop", "build"},
-		"gaming": {"multiplayer", "coop", "guild", "team"},
+// ExplainRecommendations rescores the candidates against the user's current
+// profile and breaks each match down per dimension. It does not check that
+// the candidates are recommended; callers pass IDs taken from the user's list.
+func (s *recommendationService) ExplainRecommendations(ctx context.Context, userID int, candidateIDs []int) (map[int]*RecommendationExplanation, error) {
+	profiles, err := s.repo.GetProfilesByIDs(ctx, append([]int{userID}, candidateIDs...))
+	if err != nil {
+		return nil, err
 	}
-	for primary, related := range complementaryPairs {
-		if strings.Contains(a, primary) {
-			for _, rel := range related {
-				if strings.Contains(b, rel) {
-					score += 10
-				}
-			}
-		}
-		if strings.Contains(b, primary) {
-			for _, rel := range related {
-				if strings.Contains(a, rel) {
-					score += 10
-				}
-			}
+	var viewer *Profile
+	for i := range profiles {
+		if profiles[i].UserID == userID {
+			viewer = &profiles[i]
 		}
 	}
-	categories := map[string][]string{
-		"creative":    {"art", "design", "music", "writing", "craft", "creative"},
-		"technical":   {"code", "programming", "tech", "computer", "digital"},
-		"social":      {"group", "team", "community", "meetup", "social"},
-		"educational": {"teach", "learn", "study", "workshop", "class"},
-		"gaming":      {"game", "gaming", "play", "rpg", "board"},
+	if viewer == nil {
+		return nil, ErrIncompleteProfile
 	}
-	for _, words := range categories {
-		aMatches := 0
-		bMatches := 0
-		for _, word := range words {
-			if strings.Contains(a, word) {
-				aMatches++
-			}
-			if strings.Contains(b, word) {
-				bMatches++
-			}
+
+	model := scoringConfigs.get(ctx, s.repo)
+	out := make(map[int]*RecommendationExplanation, len(candidateIDs))
+	for i := range profiles {
+		c := &profiles[i]
+		if c.UserID == userID {
+			continue
 		}
-		if aMatches > 0 && bMatches > 0 {
-			score += 5
+		distance := displayDistance(viewer.UserID, viewer.LocationLat, viewer.LocationLon, c.UserID, c.LocationLat, c.LocationLon)
+		pair := ScoringPair{Viewer: viewer, Candidate: c, DistanceKm: distance}
+		score, percentage := model.Match(pair)
+		out[c.UserID] = &RecommendationExplanation{
+			UserID:          c.UserID,
+			Score:           score,
+			ScorePercentage: percentage,
+			Dimensions:      model.Explain(pair),
 		}
 	}
-	return score
-}
-
-func calculateLocationScore(userLat, userLon, candidateLat, candidateLon float64, maxRadiusKm int, locationWeight int) int {
-	distance := haversine(userLat, userLon, candidateLat, candidateLon)
-	if maxRadiusKm > 0 && distance > float64(maxRadiusKm) {
-		return 0
-	}
-	if distance == 0 {
-		return locationWeight
-	}
-	if maxRadiusKm == 0 {
-		return locationWeight / 2
-	}
-	proximityRatio := 1.0 - (distance / float64(maxRadiusKm))
-	proximityScore := int(proximityRatio * float64(locationWeight))
-	bonus := 0
-	if distance <= 5 {
-		bonus = 5
-	} else if distance <= 15 {
-		bonus = 2
-	}
-	if locationWeight == 0 && bonus > 0 {
-		return bonus
-	}
-	return proximityScore + bonus
+	return out, nil
 }
 
 func haversine(lat1, lon1, lat2, lon2 float64) float64 {
@@ -370,74 +396,3 @@ func haversine(lat1, lon1, lat2, lon2 float64) float64 {
 	c := 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
 	return R * c
 }
-
-func calculateFoodScore(userFood, candidateFood string) int {
-	if userFood == "" || candidateFood == "" {
-		return 0
-	}
-	if strings.EqualFold(userFood, candidateFood) {
-		return 10
-	}
-	userLower := strings.ToLower(userFood)
-	candidateLower := strings.ToLower(candidateFood)
-	cuisineGroups := map[string][]string{
-		"asian":    {"chinese", "japanese", "thai", "korean", "vietnamese", "indian", "asian"},
-		"european": {"italian", "french", "german", "spanish", "greek", "european"},
-		"american": {"american", "mexican", "bbq", "burger", "pizza"},
-		"healthy":  {"vegan", "vegetarian", "organic", "salad", "healthy"},
-		"comfort":  {"comfort", "hearty", "traditional", "home-cooked"},
-	}
-	for _, group := range cuisineGroups {
-		userInGroup := false
-		candidateInGroup := false
-		for _, cuisine := range group {
-			if strings.Contains(userLower, cuisine) {
-				userInGroup = true
-			}
-			if strings.Contains(candidateLower, cuisine) {
-				candidateInGroup = true
-			}
-		}
-		if userInGroup && candidateInGroup {
-			return 6
-		}
-	}
-	return 0
-}
-
-func calculateMusicScore(userMusic, candidateMusic string) int {
-	if userMusic == "" || candidateMusic == "" {
-		return 0
-	}
-	if strings.EqualFold(userMusic, candidateMusic) {
-		return 10
-	}
-	userLower := strings.ToLower(userMusic)
-	candidateLower := strings.ToLower(candidateMusic)
-	genreGroups := map[string][]string{
-		"rock":       {"rock", "metal", "punk", "alternative", "grunge"},
-		"electronic": {"electronic", "techno", "house", "edm", "ambient", "synth"},
-		"pop":        {"pop", "mainstream", "radio", "commercial"},
-		"jazz":       {"jazz", "blues", "swing", "bebop"},
-		"classical":  {"classical", "orchestra", "symphony", "opera"},
-		"hip-hop":    {"hip-hop", "rap", "urban", "r&b"},
-		"indie":      {"indie", "independent", "alternative", "underground"},
-		"world":      {"world", "folk", "traditional", "ethnic"},
-	}
-	for _, group := range genreGroups {
-		userInGroup := false
-		candidateInGroup := false
-		for _, genre := range group {
-			if strings.Contains(userLower, genre) {
-				userInGroup = true
-			}
-			if strings.Contains(candidateLower, genre) {
-				candidateInGroup = true
-			}
-		}
-		if userInGroup && candidateInGroup {
-			return 6
-		}
-	}
-	return 0
-}
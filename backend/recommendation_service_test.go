@@ -0,0 +1,128 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRecommendationCursor(t *testing.T) {
+	cursor := encodeRecommendationCursor("abc123", 20)
+	id, offset, err := decodeRecommendationCursor(cursor)
+	if err != nil || id != "abc123" || offset != 20 {
+		t.Fatalf("round trip failed: %q %d %v", id, offset, err)
+	}
+
+	for _, bad := range []string{"%%%", encodeRecommendationCursor("", 1), "YWJj", "YWJjOi0x"} {
+		if _, _, err := decodeRecommendationCursor(bad); !errors.Is(err, ErrInvalidCursor) {
+			t.Errorf("expected %q to be rejected, got %v", bad, err)
+		}
+	}
+}
+
+type stubSnapshotRepo struct {
+	RecommendationRepository
+	owner     int
+	results   []RecommendationResult
+	expiresAt time.Time
+}
+
+func (r stubSnapshotRepo) GetSnapshot(ctx context.Context, id string) (int, []byte, time.Time, error) {
+	if id != "snap" {
+		return 0, nil, time.Time{}, ErrNotFound
+	}
+	raw, _ := json.Marshal(r.results)
+	return r.owner, raw, r.expiresAt, nil
+}
+
+func TestRecommendationPageFromSnapshot(t *testing.T) {
+	var results []RecommendationResult
+	for i := 1; i <= 25; i++ {
+		results = append(results, RecommendationResult{UserID: i, Score: 100 - i})
+	}
+	repo := stubSnapshotRepo{owner: 7, results: results, expiresAt: time.Now().Add(time.Minute)}
+	svc := NewRecommendationService(repo)
+	ctx := context.Background()
+
+	page, err := svc.GetRecommendationPage(ctx, 7, encodeRecommendationCursor("snap", 10), 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page.Results) != 10 || page.Results[0].UserID != 11 || !page.HasNext() {
+		t.Fatalf("unexpected second page: %+v", page)
+	}
+
+	page, err = svc.GetRecommendationPage(ctx, 7, page.NextCursor(), 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page.Results) != 5 || page.Results[0].UserID != 21 || page.HasNext() || page.NextCursor() != "" {
+		t.Fatalf("unexpected last page: %+v", page)
+	}
+
+	if _, err := svc.GetRecommendationPage(ctx, 8, encodeRecommendationCursor("snap", 10), 10); !errors.Is(err, ErrInvalidCursor) {
+		t.Fatalf("expected another user's cursor to be rejected, got %v", err)
+	}
+	if _, err := svc.GetRecommendationPage(ctx, 7, encodeRecommendationCursor("gone", 10), 10); !errors.Is(err, ErrCursorExpired) {
+		t.Fatalf("expected a pruned snapshot to be expired, got %v", err)
+	}
+
+	repo.expiresAt = time.Now().Add(-time.Minute)
+	svc = NewRecommendationService(repo)
+	if _, err := svc.GetRecommendationPage(ctx, 7, encodeRecommendationCursor("snap", 10), 10); !errors.Is(err, ErrCursorExpired) {
+		t.Fatalf("expected an expired snapshot to be rejected, got %v", err)
+	}
+}
+
+type stubCachedRepo struct {
+	RecommendationRepository
+	cached   []RecommendationResult
+	computed bool
+	complete bool
+	deleted  bool
+}
+
+func (r *stubCachedRepo) GetCachedRecommendations(ctx context.Context, userID int) ([]RecommendationResult, bool, error) {
+	return r.cached, r.computed, nil
+}
+
+func (r *stubCachedRepo) IsCachedRecommendation(ctx context.Context, userID, candidateID int) (bool, bool, error) {
+	for _, res := range r.cached {
+		if res.UserID == candidateID {
+			return true, r.computed, nil
+		}
+	}
+	return false, r.computed, nil
+}
+
+func (r *stubCachedRepo) CheckProfileComplete(ctx context.Context, userID int) (bool, error) {
+	return r.complete, nil
+}
+
+func (r *stubCachedRepo) DeleteRecommendations(ctx context.Context, userID int) error {
+	r.deleted = true
+	return nil
+}
+
+func TestRecommendationsFromCache(t *testing.T) {
+	ctx := context.Background()
+	repo := &stubCachedRepo{cached: []RecommendationResult{{UserID: 3}, {UserID: 5}}, computed: true}
+	svc := NewRecommendationService(repo)
+
+	ids, err := svc.GetRecommendedUserIDs(ctx, 1)
+	if err != nil || len(ids) != 2 || ids[1] != 5 {
+		t.Fatalf("expected the cached list, got %v %v", ids, err)
+	}
+	if ok, err := svc.IsCurrentlyRecommendable(ctx, 1, 5); err != nil || !ok {
+		t.Fatalf("expected 5 to be recommendable, got %v %v", ok, err)
+	}
+
+	// never computed and incomplete: the list is dropped instead of scored
+	repo = &stubCachedRepo{}
+	svc = NewRecommendationService(repo)
+	if ok, err := svc.IsCurrentlyRecommendable(ctx, 1, 5); err != nil || ok || !repo.deleted {
+		t.Fatalf("expected a refresh that drops the list, got %v %v deleted=%v", ok, err, repo.deleted)
+	}
+}
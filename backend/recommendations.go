@@ -1,71 +1,190 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"net/http"
 	"strconv"
 	"strings"
+
+	"gitea.kood.tech/petrkubec/match-me/backend/graph"
+	"gitea.kood.tech/petrkubec/match-me/backend/graph/model"
 )
 
+// parseRecommendationPaging reads the optional ?cursor= and ?limit= parameters
+func parseRecommendationPaging(r *http.Request) (string, int, error) {
+	cursor := r.URL.Query().Get("cursor")
+	limit := 0
+	if v := r.URL.Query().Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			return "", 0, errors.New("invalid_limit")
+		}
+		limit = n
+	}
+	return cursor, limit, nil
+}
+
+// loadRecommendationPage runs the shared checks of the paged endpoints and
+// writes the error response itself when it returns nil
+func loadRecommendationPage(w http.ResponseWriter, r *http.Request, svc RecommendationService) *RecommendationPage {
+	userID := r.Context().Value(userIDKey).(int)
+
+	cursor, limit, err := parseRecommendationPaging(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return nil
+	}
+
+	isComplete, err := svc.CheckProfileComplete(r.Context(), userID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db_error")
+		return nil
+	}
+	if !isComplete {
+		writeError(w, http.StatusForbidden, "incomplete_profile")
+		return nil
+	}
+
+	page, err := svc.GetRecommendationPage(r.Context(), userID, cursor, limit)
+	switch {
+	case errors.Is(err, ErrInvalidCursor):
+		writeError(w, http.StatusBadRequest, "invalid_cursor")
+		return nil
+	case errors.Is(err, ErrCursorExpired):
+		writeError(w, http.StatusGone, "cursor_expired")
+		return nil
+	case err != nil:
+		writeError(w, http.StatusInternalServerError, "recommendation_error")
+		return nil
+	}
+	return page
+}
+
+// nextCursorJSON is the page's next cursor, or nil at the end of the feed
+func nextCursorJSON(page *RecommendationPage) *string {
+	if next := page.NextCursor(); next != "" {
+		return &next
+	}
+	return nil
+}
+
+// GET /recommendations?cursor=&limit= - Returns one page of recommended user IDs
 func recommendationsHandler(db *sql.DB) http.HandlerFunc {
 	repo := NewRecommendationRepository(db)
 	svc := NewRecommendationService(repo)
 	return authenticate(func(w http.ResponseWriter, r *http.Request) {
-		userID := r.Context().Value(userIDKey).(int)
-
-		isComplete, err := svc.CheckProfileComplete(r.Context(), userID)
-		if err != nil {
-			writeError(w, http.StatusInternalServerError, "db_error")
+		page := loadRecommendationPage(w, r, svc)
+		if page == nil {
 			return
 		}
-		if !isComplete {
-			writeError(w, http.StatusForbidden, "incomplete_profile")
+
+		recommendations := make([]int, 0, len(page.Results))
+		for _, result := range page.Results {
+			recommendations = append(recommendations, result.UserID)
+		}
+
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"recommendations": recommendations,
+			"next_cursor":     nextCursorJSON(page),
+		})
+	})
+}
+
+// detailedRecommendation is one entry of /recommendations/detailed
+type detailedRecommendation struct {
+	UserID          int                    `json:"user_id"`
+	Score           int                    `json:"score"`
+	ScorePercentage float64                `json:"score_percentage"`
+	Distance        string                 `json:"distance,omitempty"`
+	Explanation     []DimensionExplanation `json:"explanation"`
+}
+
+// GET /recommendations/detailed?cursor=&limit= - Returns one page of recommendations with scores
+// and a per-dimension explanation of each match
+func recommendationsDetailedHandler(db *sql.DB) http.HandlerFunc {
+	repo := NewRecommendationRepository(db)
+	svc := NewRecommendationService(repo)
+	return authenticate(func(w http.ResponseWriter, r *http.Request) {
+		page := loadRecommendationPage(w, r, svc)
+		if page == nil {
 			return
 		}
 
-		recommendations, err := svc.GetRecommendedUserIDs(r.Context(), userID)
+		ids := make([]int, len(page.Results))
+		for i, result := range page.Results {
+			ids[i] = result.UserID
+		}
+		explanations, err := svc.ExplainRecommendations(r.Context(), r.Context().Value(userIDKey).(int), ids)
 		if err != nil {
 			writeError(w, http.StatusInternalServerError, "recommendation_error")
 			return
 		}
-		
-		if recommendations == nil {
-			recommendations = []int{}
+
+		results := make([]detailedRecommendation, 0, len(page.Results))
+		for _, result := range page.Results {
+			entry := detailedRecommendation{
+				UserID:          result.UserID,
+				Score:           result.Score,
+				ScorePercentage: result.ScorePercentage,
+				Explanation:     []DimensionExplanation{},
+			}
+			if result.Distance > 0 {
+				entry.Distance = distanceBucket(result.Distance)
+			}
+			if e := explanations[result.UserID]; e != nil {
+				entry.Explanation = e.Dimensions
+			}
+			results = append(results, entry)
 		}
 
-		writeJSON(w, http.StatusOK, map[string][]int{"recommendations": recommendations})
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"recommendations": results,
+			"next_cursor":     nextCursorJSON(page),
+		})
 	})
 }
 
-// GET /recommendations/detailed - Returns recommendations with scores
-func recommendationsDetailedHandler(db *sql.DB) http.HandlerFunc {
+// recommendationActionsRouter dispatches /recommendations/{id}/...
+func recommendationActionsRouter(db *sql.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+		if len(parts) == 3 && parts[2] == "explanation" {
+			recommendationExplanationHandler(db).ServeHTTP(w, r)
+			return
+		}
+		dismissRecommendationHandler(db).ServeHTTP(w, r)
+	}
+}
+
+// GET /recommendations/{id}/explanation - Explains why a recommended user matches
+func recommendationExplanationHandler(db *sql.DB) http.HandlerFunc {
 	repo := NewRecommendationRepository(db)
 	svc := NewRecommendationService(repo)
 	return authenticate(func(w http.ResponseWriter, r *http.Request) {
-		userID := r.Context().Value(userIDKey).(int)
-		
-		isComplete, err := svc.CheckProfileComplete(r.Context(), userID)
-		if err != nil {
-			writeError(w, http.StatusInternalServerError, "db_error")
+		if r.Method != http.MethodGet {
+			writeError(w, http.StatusMethodNotAllowed, "invalid_method")
 			return
 		}
-		if !isComplete {
-			writeError(w, http.StatusForbidden, "incomplete_profile")
+		parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+		id, err := strconv.Atoi(parts[1])
+		if err != nil {
+			writeError(w, http.StatusNotFound, "not_found")
 			return
 		}
 
-		results, err := svc.GetRecommendationsWithScores(r.Context(), userID)
+		userID := r.Context().Value(userIDKey).(int)
+		explanation, err := svc.ExplainRecommendation(r.Context(), userID, id)
 		if err != nil {
+			if errors.Is(err, ErrNotFound) {
+				writeError(w, http.StatusNotFound, "not_found")
+				return
+			}
 			writeError(w, http.StatusInternalServerError, "recommendation_error")
 			return
 		}
-
-		if results == nil {
-			results = []RecommendationResult{}
-		}
-
-		writeJSON(w, http.StatusOK, map[string][]RecommendationResult{"recommendations": results})
+		writeJSON(w, http.StatusOK, explanation)
 	})
 }
 
@@ -105,3 +224,102 @@ func dismissRecommendationHandler(db *sql.DB) http.HandlerFunc {
 		writeJSON(w, http.StatusCreated, map[string]bool{"dismissed": true})
 	})
 }
+
+// graphRecommendationService exposes RecommendationService to the GraphQL layer
+type graphRecommendationService struct {
+	RecommendationService
+}
+
+func (g graphRecommendationService) RecommendationPage(ctx context.Context, userID int, after string, first int) (*graph.RecommendationPage, error) {
+	isComplete, err := g.CheckProfileComplete(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !isComplete {
+		return nil, ErrIncompleteProfile
+	}
+
+	page, err := g.GetRecommendationPage(ctx, userID, after, first)
+	if err != nil {
+		return nil, err
+	}
+	out := &graph.RecommendationPage{
+		HasNextPage:     page.HasNext(),
+		HasPreviousPage: page.Offset > 0,
+		TotalCount:      page.Total,
+	}
+	for i, result := range page.Results {
+		item := graph.RecommendationItem{
+			UserID:          result.UserID,
+			Score:           result.Score,
+			ScorePercentage: result.ScorePercentage,
+			Cursor:          page.Cursor(i),
+		}
+		if result.Distance > 0 {
+			item.Distance = nonEmptyString(distanceBucket(result.Distance))
+		}
+		out.Items = append(out.Items, item)
+	}
+	return out, nil
+}
+
+func (g graphRecommendationService) Explanation(ctx context.Context, userID, candidateID int) (*model.RecommendationExplanation, error) {
+	e, err := g.ExplainRecommendation(ctx, userID, candidateID)
+	if errors.Is(err, ErrNotFound) {
+		return nil, graph.ErrNotRecommended
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toGraphExplanation(e), nil
+}
+
+func (g graphRecommendationService) Explanations(ctx context.Context, userID int, candidateIDs []int) (map[int]*model.RecommendationExplanation, error) {
+	explanations, err := g.ExplainRecommendations(ctx, userID, candidateIDs)
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[int]*model.RecommendationExplanation, len(explanations))
+	for id, e := range explanations {
+		out[id] = toGraphExplanation(e)
+	}
+	return out, nil
+}
+
+func toGraphExplanation(e *RecommendationExplanation) *model.RecommendationExplanation {
+	out := &model.RecommendationExplanation{
+		UserID:          strconv.Itoa(e.UserID),
+		Score:           e.Score,
+		ScorePercentage: e.ScorePercentage,
+		Dimensions:      make([]*model.DimensionExplanation, 0, len(e.Dimensions)),
+	}
+	for _, d := range e.Dimensions {
+		out.Dimensions = append(out.Dimensions, &model.DimensionExplanation{
+			Dimension:     d.Dimension,
+			Weight:        d.Weight,
+			Points:        d.Points,
+			Shared:        nonNilStrings(d.Shared),
+			Groups:        nonNilStrings(d.Groups),
+			Keywords:      nonNilStrings(d.Keywords),
+			Complementary: nonNilStrings(d.Complementary),
+			Distance:      nonEmptyString(d.Distance),
+		})
+	}
+	return out
+}
+
+// nonEmptyString maps "" to a null GraphQL string
+func nonEmptyString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// nonNilStrings turns nil into an empty list for non-null GraphQL lists
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"sync"
+	"time"
+
+	"gitea.kood.tech/petrkubec/match-me/backend/events"
+)
+
+// typingTimeout is how long a typing indicator survives without a refresh.
+// Clients re-send "typing" while the user keeps typing; if they go silent the
+// server reports the stop itself.
+const typingTimeout = 5 * time.Second
+
+type typingKey struct {
+	userID int
+	peerID int
+}
+
+type typingState struct {
+	chatID   int
+	deadline time.Time
+	timer    *time.Timer
+}
+
+// typingTracker keeps the typing state per (user, peer) pair and publishes
+// start/stop transitions on the event bus.
+type typingTracker struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	active  map[typingKey]*typingState
+	publish func(events.Typing)
+}
+
+func newTypingTracker(ttl time.Duration) *typingTracker {
+	return &typingTracker{
+		ttl:     ttl,
+		active:  make(map[typingKey]*typingState),
+		publish: events.Default().PublishTyping,
+	}
+}
+
+// global tracker shared by the WebSocket and GraphQL transports
+var typingStatus = newTypingTracker(typingTimeout)
+
+// Start marks userID as typing to peerID, or refreshes the expiry if they
+// already are. Only the first call of a run emits an event.
+func (t *typingTracker) Start(chatID, userID, peerID int) {
+	key := typingKey{userID: userID, peerID: peerID}
+
+	t.mu.Lock()
+	if st, ok := t.active[key]; ok {
+		st.deadline = time.Now().Add(t.ttl)
+		st.timer.Reset(t.ttl)
+		t.mu.Unlock()
+		return
+	}
+	st := &typingState{chatID: chatID, deadline: time.Now().Add(t.ttl)}
+	st.timer = time.AfterFunc(t.ttl, func() { t.expire(key, st) })
+	t.active[key] = st
+	t.mu.Unlock()
+
+	t.publish(events.Typing{ChatID: chatID, UserID: userID, PeerID: peerID, Typing: true})
+}
+
+// Stop ends the typing run of userID towards peerID, if any
+func (t *typingTracker) Stop(userID, peerID int) {
+	key := typingKey{userID: userID, peerID: peerID}
+
+	t.mu.Lock()
+	st, ok := t.active[key]
+	if ok {
+		st.timer.Stop()
+		delete(t.active, key)
+	}
+	t.mu.Unlock()
+
+	if ok {
+		t.publish(events.Typing{ChatID: st.chatID, UserID: userID, PeerID: peerID, Typing: false})
+	}
+}
+
+func (t *typingTracker) expire(key typingKey, st *typingState) {
+	t.mu.Lock()
+	if t.active[key] != st {
+		t.mu.Unlock()
+		return
+	}
+	if left := time.Until(st.deadline); left > 0 {
+		// Refreshed while this timer was already firing
+		st.timer.Reset(left)
+		t.mu.Unlock()
+		return
+	}
+	delete(t.active, key)
+	t.mu.Unlock()
+
+	t.publish(events.Typing{ChatID: st.chatID, UserID: key.userID, PeerID: key.peerID, Typing: false})
+}
+
+// handleEvent stops the sender's typing indicator once their message lands
+func (t *typingTracker) handleEvent(evt events.Event) {
+	if evt.Type != events.MessageCreated {
+		return
+	}
+	if msg, ok := evt.Payload.(events.Message); ok {
+		t.Stop(msg.SenderID, msg.RecipientID)
+	}
+}
+
+// TypingEvent is the data of a "typing" WebSocket event
+type TypingEvent struct {
+	ChatID int  `json:"chat_id,omitempty"`
+	Typing bool `json:"typing"`
+}
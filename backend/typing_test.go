@@ -0,0 +1,95 @@
+package main
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"gitea.kood.tech/petrkubec/match-me/backend/events"
+)
+
+func TestTypingTracker(t *testing.T) {
+	var (
+		mu   sync.Mutex
+		seen []events.Typing
+		ttl  = 50 * time.Millisecond
+	)
+	tracker := newTypingTracker(ttl)
+	tracker.publish = func(evt events.Typing) {
+		mu.Lock()
+		defer mu.Unlock()
+		seen = append(seen, evt)
+	}
+	published := func() []events.Typing {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]events.Typing(nil), seen...)
+	}
+
+	t.Run("Start is emitted once per run", func(t *testing.T) {
+		tracker.Start(7, 1, 2)
+		tracker.Start(7, 1, 2)
+		got := published()
+		if len(got) != 1 || !got[0].Typing || got[0].ChatID != 7 || got[0].PeerID != 2 {
+			t.Fatalf("Expected a single start event, got %+v", got)
+		}
+	})
+
+	t.Run("Explicit stop", func(t *testing.T) {
+		tracker.Stop(1, 2)
+		tracker.Stop(1, 2) // no run in progress, no event
+		got := published()
+		if len(got) != 2 || got[1].Typing {
+			t.Fatalf("Expected a single stop event, got %+v", got)
+		}
+	})
+
+	t.Run("Expires after silence", func(t *testing.T) {
+		tracker.Start(7, 1, 2)
+		time.Sleep(ttl / 2)
+		tracker.Start(7, 1, 2) // refresh keeps it alive
+		time.Sleep(ttl / 2)
+		if got := published(); len(got) != 3 {
+			t.Fatalf("Expected the refresh to postpone expiry, got %+v", got)
+		}
+
+		time.Sleep(2 * ttl)
+		got := published()
+		if len(got) != 4 || got[3].Typing || got[3].UserID != 1 {
+			t.Fatalf("Expected an automatic stop event, got %+v", got)
+		}
+	})
+
+	t.Run("Sending a message stops typing", func(t *testing.T) {
+		tracker.Start(7, 1, 2)
+		tracker.handleEvent(events.Event{
+			Type:    events.MessageCreated,
+			Payload: events.Message{ChatID: 7, SenderID: 1, RecipientID: 2, Body: "hi"},
+		})
+		got := published()
+		if len(got) != 6 || got[5].Typing {
+			t.Fatalf("Expected a stop event after the message, got %+v", got)
+		}
+	})
+}
+
+func TestHubHandleTypingEvent(t *testing.T) {
+	hub := newHub()
+	peer := &Client{userID: 60, send: make(chan ServerEvent, 4)}
+	hub.register(peer)
+
+	hub.handleEvent(events.Event{
+		Type:    events.TypingChanged,
+		Payload: events.Typing{ChatID: 3, UserID: 70, PeerID: 60, Typing: false},
+	})
+
+	select {
+	case evt := <-peer.send:
+		data, ok := evt.Data.(TypingEvent)
+		if evt.Type != "typing" || evt.From != 70 || !ok || data.Typing || data.ChatID != 3 {
+			t.Errorf("unexpected event %#v", evt)
+		}
+	case <-time.After(100 * time.Millisecond):
+		t.Error("peer did not receive the typing event")
+	}
+}
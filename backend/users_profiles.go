@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"log"
 	"net/http"
 	"strconv"
@@ -138,6 +139,10 @@ func completeProfileHandler(db *sql.DB) http.HandlerFunc {
 		userID := r.Context().Value(userIDKey).(int)
 
 		err := svc.UpsertProfile(r.Context(), userID, req)
+		if errors.Is(err, ErrUnknownCity) || errors.Is(err, ErrLocationMismatch) {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
 		if err != nil {
 			writeError(w, http.StatusInternalServerError, "profile_save_error")
 			log.Println("Error saving profile:", err)
@@ -204,8 +209,8 @@ func meBioHandler(db *sql.DB) http.HandlerFunc {
 	})
 }
 
-// Backward compatibility wrapper for avatar.go
+// canViewUser applies the access policy, treating errors as a denial
 func canViewUser(ctx context.Context, db *sql.DB, viewerID, targetID int) bool {
-	repo := NewUserProfileRepository(db)
-	return repo.CanViewUser(ctx, viewerID, targetID)
+	ok, err := NewAccessPolicy(db).CanViewUser(ctx, viewerID, targetID)
+	return err == nil && ok
 }
@@ -50,7 +50,7 @@ type UserProfileRepository interface {
 	GetFullProfile(ctx context.Context, userID int) (UserProfileData, error)
 	GetMeBio(ctx context.Context, userID int) (json.RawMessage, json.RawMessage, string, string, error)
 	UpsertProfile(ctx context.Context, userID int, req ProfileRequest) error
-	CanViewUser(ctx context.Context, viewerID, targetID int) bool
+	HasConnection(ctx context.Context, userID, otherID int) (bool, error)
 }
 
 type sqlUserProfileRepo struct {
@@ -158,12 +158,16 @@ func (r *sqlUserProfileRepo) UpsertProfile(ctx context.Context, userID int, req
 	return err
 }
 
-func (r *sqlUserProfileRepo) CanViewUser(ctx context.Context, viewerID, targetID int) bool {
-	var count int
+// HasConnection reports whether the users have a pending or accepted
+// connection, in either direction
+func (r *sqlUserProfileRepo) HasConnection(ctx context.Context, userID, otherID int) (bool, error) {
+	var exists bool
 	err := r.db.QueryRowContext(ctx, `
-		SELECT COUNT(*) FROM connections
-		WHERE ((user_id = $1 AND target_user_id = $2) OR (user_id = $2 AND target_user_id = $1))
-		AND status IN ('accepted', 'pending')
-	`, viewerID, targetID).Scan(&count)
-	return err == nil && count > 0
+		SELECT EXISTS (
+			SELECT 1 FROM connections
+			WHERE ((user_id = $1 AND target_user_id = $2) OR (user_id = $2 AND target_user_id = $1))
+			AND status IN ('accepted', 'pending')
+		)
+	`, userID, otherID).Scan(&exists)
+	return exists, err
 }
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"encoding/json"
 
+	"gitea.kood.tech/petrkubec/match-me/backend/events"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -19,12 +20,13 @@ type UserProfileService interface {
 }
 
 type userProfileService struct {
-	repo UserProfileRepository
-	db   *sql.DB
+	repo   UserProfileRepository
+	db     *sql.DB
+	policy AccessPolicy
 }
 
 func NewUserProfileService(repo UserProfileRepository, db *sql.DB) UserProfileService {
-	return &userProfileService{repo: repo, db: db}
+	return &userProfileService{repo: repo, db: db, policy: NewAccessPolicy(db)}
 }
 
 func (s *userProfileService) GetBasicUserInfoWithPresence(ctx context.Context, userID int) (map[string]interface{}, error) {
@@ -43,17 +45,9 @@ func (s *userProfileService) GetBasicUserInfoWithPresence(ctx context.Context, u
 }
 
 func (s *userProfileService) GetTargetProfile(ctx context.Context, requesterID, targetID int) (map[string]interface{}, error) {
-	allowed := s.repo.CanViewUser(ctx, requesterID, targetID)
-	if !allowed {
-		recs, err := getRecommendedUserIDs(ctx, s.db, requesterID)
-		if err == nil {
-			for _, id := range recs {
-				if id == targetID {
-					allowed = true
-					break
-				}
-			}
-		}
+	allowed, err := s.policy.CanViewUser(ctx, requesterID, targetID)
+	if err != nil {
+		return nil, err
 	}
 	if !allowed {
 		return nil, ErrNotFound
@@ -64,6 +58,7 @@ func (s *userProfileService) GetTargetProfile(ctx context.Context, requesterID,
 	var (
 		aboutMe, displayName, profilePicture string
 		locationLat, locationLon             sql.NullFloat64
+		viewerLat, viewerLon                 sql.NullFloat64
 		onlineDB                             bool
 	)
 
@@ -90,6 +85,16 @@ func (s *userProfileService) GetTargetProfile(ctx context.Context, requesterID,
 		return nil
 	})
 
+	if requesterID != targetID {
+		g.Go(func() error {
+			lat, lon, err := s.repo.GetProfileLocation(gCtx, requesterID)
+			if err == nil {
+				viewerLat, viewerLon = lat, lon
+			}
+			return nil
+		})
+	}
+
 	g.Go(func() error {
 		onlineDB, _ = isOnlineNow(gCtx, s.db, targetID)
 		select {
@@ -115,27 +120,25 @@ func (s *userProfileService) GetTargetProfile(ctx context.Context, requesterID,
 		"is_online":       onlineDB,
 	}
 
-	if locationLat.Valid {
-		resp["location_lat"] = locationLat.Float64
-	}
-	if locationLon.Valid {
-		resp["location_lon"] = locationLon.Float64
+	if locationLat.Valid && locationLon.Valid {
+		lat, lon := locationLat.Float64, locationLon.Float64
+		if requesterID != targetID {
+			// others only get the jittered grid point and a distance range
+			if viewerLat.Valid && viewerLon.Valid {
+				resp["distance"] = distanceBucket(displayDistance(requesterID, viewerLat.Float64, viewerLon.Float64, targetID, lat, lon))
+			}
+			lat, lon = displayLocation(targetID, lat, lon)
+		}
+		resp["location_lat"] = lat
+		resp["location_lon"] = lon
 	}
 	return resp, nil
 }
 
 func (s *userProfileService) GetTargetBio(ctx context.Context, requesterID, targetID int) (map[string]interface{}, error) {
-	allowed := s.repo.CanViewUser(ctx, requesterID, targetID)
-	if !allowed {
-		recs, err := getRecommendedUserIDs(ctx, s.db, requesterID)
-		if err == nil {
-			for _, id := range recs {
-				if id == targetID {
-					allowed = true
-					break
-				}
-			}
-		}
+	allowed, err := s.policy.CanViewUser(ctx, requesterID, targetID)
+	if err != nil {
+		return nil, err
 	}
 	if !allowed {
 		return nil, ErrNotFound
@@ -156,8 +159,23 @@ func (s *userProfileService) GetTargetBio(ctx context.Context, requesterID, targ
 	}, nil
 }
 
+// UpsertProfile saves the profile. A city sent without coordinates is
+// geocoded; ErrUnknownCity and ErrLocationMismatch report locations that
+// cannot be reconciled.
 func (s *userProfileService) UpsertProfile(ctx context.Context, userID int, req ProfileRequest) error {
-	return s.repo.UpsertProfile(ctx, userID, req)
+	if req.LocationCity != "" {
+		hasCoords := req.LocationLat != 0 || req.LocationLon != 0
+		city, lat, lon, err := gazetteer().ResolveLocation(req.LocationCity, req.LocationLat, req.LocationLon, hasCoords)
+		if err != nil {
+			return err
+		}
+		req.LocationCity, req.LocationLat, req.LocationLon = city, lat, lon
+	}
+	if err := s.repo.UpsertProfile(ctx, userID, req); err != nil {
+		return err
+	}
+	events.Default().PublishProfile(events.Profile{UserID: userID})
+	return nil
 }
 
 func (s *userProfileService) GetMeBio(ctx context.Context, userID int) (map[string]interface{}, error) {
@@ -1,8 +1,14 @@
 package main
 
 import (
+	"context"
+	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
 )
 
 func TestGetUserIDFromRequest(t *testing.T) {
@@ -205,3 +211,92 @@ func TestJwtParse(t *testing.T) {
 		}
 	})
 }
+
+func TestClientWriterTokenExpiry(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		c := &Client{
+			userID:   1,
+			conn:     conn,
+			send:     make(chan ServerEvent, 4),
+			tokenExp: time.Now().Add(50 * time.Millisecond),
+			reauth:   make(chan time.Time, 1),
+		}
+		clientWriter(c)
+	}))
+	defer srv.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http"), nil)
+	if err != nil {
+		t.Fatalf("dial failed: %v", err)
+	}
+	defer conn.Close()
+
+	_ = conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+	var evt ServerEvent
+	if err := conn.ReadJSON(&evt); err != nil {
+		t.Fatalf("expected a reauth event, got error: %v", err)
+	}
+	if evt.Type != "reauth" {
+		t.Errorf("expected reauth event, got %#v", evt)
+	}
+}
+
+// countingChatService records delivery acks without a database
+type countingChatService struct {
+	ChatService
+	delivered chan int64
+}
+
+func (s countingChatService) MarkDelivered(ctx context.Context, userID int, msgID int64) error {
+	s.delivered <- msgID
+	return nil
+}
+
+func TestClientReaderLimitsDeliveryAcks(t *testing.T) {
+	saved := rateLimiter
+	defer func() { rateLimiter = saved }()
+	rateLimiter = NewRateLimiter(newMemoryRateLimitStore())
+	rateLimiter.rules = map[string]RateRule{limitWSAck: {Burst: 2, Every: time.Minute}}
+
+	svc := countingChatService{delivered: make(chan int64, 8)}
+	client := make(chan *Client, 1)
+	done := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		c := &Client{userID: 1, conn: conn, send: make(chan ServerEvent, 8), chatSvc: svc}
+		client <- c
+		clientReader(c)
+		close(done)
+	}))
+	defer srv.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http"), nil)
+	if err != nil {
+		t.Fatalf("dial failed: %v", err)
+	}
+	for id := 1; id <= 4; id++ {
+		if err := conn.WriteJSON(map[string]interface{}{"type": "delivered", "id": id}); err != nil {
+			t.Fatalf("write failed: %v", err)
+		}
+	}
+	conn.Close()
+	<-done
+	c := <-client
+
+	if n := len(svc.delivered); n != 2 {
+		t.Errorf("expected 2 acks through the limit, got %d", n)
+	}
+	if n := len(c.send); n != 2 {
+		t.Fatalf("expected 2 rate_limited errors, got %d", n)
+	}
+	if evt := <-c.send; evt.Type != "error" || evt.Data != codeRateLimited {
+		t.Errorf("expected a rate_limited error, got %#v", evt)
+	}
+}
@@ -19,12 +19,51 @@ api.interceptors.request.use((config) => {
   return config;
 })
 
-// If backend says 401, clear token and go to login
+// Access tokens are short-lived; renew them with the rotating refresh token.
+// Concurrent callers share one in-flight refresh, since every refresh token
+// can be used exactly once.
+let refreshing: Promise<string | null> | null = null;
+
+export function refreshAccessToken(): Promise<string | null> {
+  if (refreshing) return refreshing;
+  const refreshToken = localStorage.getItem('refreshToken');
+  if (!refreshToken) return Promise.resolve(null);
+
+  refreshing = axios
+    .post<{ token: string; refresh_token: string }>(`${baseURL}/token/refresh`, { refresh_token: refreshToken })
+    .then(({ data }) => {
+      localStorage.setItem('token', data.token);
+      localStorage.setItem('refreshToken', data.refresh_token);
+      return data.token;
+    })
+    .catch(() => {
+      localStorage.removeItem('refreshToken');
+      return null;
+    })
+    .finally(() => {
+      refreshing = null;
+    });
+  return refreshing;
+}
+
+// If backend says 401, try one refresh and replay the request;
+// otherwise clear the session and go to login
 api.interceptors.response.use(
   (res) => res,
-  (error) => {
+  async (error) => {
+    const original = error?.config;
+    if (error?.response?.status === 401 && original && !original._retried && !original.url?.includes('/login')) {
+      original._retried = true;
+      const token = await refreshAccessToken();
+      if (token) {
+        original.headers = original.headers ?? {};
+        original.headers.Authorization = `Bearer ${token}`;
+        return api(original);
+      }
+    }
     if (error?.response?.status === 401) {
       localStorage.removeItem("token");
+      localStorage.removeItem("refreshToken");
       if (location.pathname !== "/") location.assign("/");
     }
     return Promise.reject(error);
@@ -3,24 +3,35 @@ import type { RecommendationsResponse, RecommendationsDetailedResponse } from ".
 import type { CandidateDisplay, RecommendationWithScore } from "../types/ui";
 import { hydrateCandidate } from "./users";
 
-/** GET /recommendations → ordered list of user IDs (max 10) */
+/** GET /recommendations → first page of user IDs, strongest-first */
 export async function getRecommendationIds(): Promise<number[]> {
   const { data } = await api.get<RecommendationsResponse>("/recommendations");
   return data.recommendations ?? [];
 }
 
-/** GET /recommendations/detailed → ordered list with scores and percentages */
-export async function getRecommendationsWithScores(): Promise<RecommendationWithScore[]> {
-  const { data } = await api.get<RecommendationsDetailedResponse>("/recommendations/detailed");
-  return data.recommendations ?? [];
+export type RecommendationPage<T> = {
+  items: T[];
+  nextCursor: string | null;
+};
+
+/**
+ * GET /recommendations/detailed?cursor= → one page with scores and percentages.
+ * Without a cursor the server ranks afresh; with one it continues the same
+ * ranked snapshot, so pages never reshuffle.
+ */
+export async function getRecommendationsWithScores(cursor?: string): Promise<RecommendationPage<RecommendationWithScore>> {
+  const { data } = await api.get<RecommendationsDetailedResponse>("/recommendations/detailed", {
+    params: cursor ? { cursor } : undefined,
+  });
+  return { items: data.recommendations ?? [], nextCursor: data.next_cursor ?? null };
 }
 
 /**
- * Fetch the current recommendation IDs, hydrate each to a CandidateDisplay,
+ * Fetch a page of recommendations, hydrate each to a CandidateDisplay,
  * preserve the original order, and gracefully skip any racey 404s.
  */
-export async function hydrateRecommendations(): Promise<CandidateDisplay[]> {
-  const recommendations = await getRecommendationsWithScores();
+export async function hydrateRecommendations(cursor?: string): Promise<RecommendationPage<CandidateDisplay>> {
+  const { items: recommendations, nextCursor } = await getRecommendationsWithScores(cursor);
 
   const results = await Promise.all(
     recommendations.map(async (rec) => {
@@ -44,7 +55,7 @@ export async function hydrateRecommendations(): Promise<CandidateDisplay[]> {
   );
 
   // Keep order, drop nulls
-  return results.filter((x): x is CandidateDisplay => x !== null);
+  return { items: results.filter((x): x is CandidateDisplay => x !== null), nextCursor };
 }
 
 export async function dismissCandidate(id: number): Promise<void> {
@@ -22,10 +22,11 @@ function RegisterForm() {
 
     try {
       // Register the user (which now returns a token)
-      const response = await axios.post<{ token: string; id: number }>('/register', { email, password })
+      const response = await axios.post<{ token: string; refresh_token: string; id: number }>('/register', { email, password })
       
-      // Store the token in localStorage
+      // Store the tokens in localStorage
       localStorage.setItem('token', response.data.token)
+      localStorage.setItem('refreshToken', response.data.refresh_token)
       
       // Refresh auth context to pick up the new token immediately
       await refreshAuth()
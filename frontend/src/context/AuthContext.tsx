@@ -85,6 +85,7 @@ export const AuthProvider: React.FC<React.PropsWithChildren> = ({ children }) =>
         }
         // Other errors: treat as invalid session
         localStorage.removeItem("token");
+        localStorage.removeItem("refreshToken");
         setToken(null);
         setUser(null);
       } finally {
@@ -95,8 +96,9 @@ export const AuthProvider: React.FC<React.PropsWithChildren> = ({ children }) =>
   }, [token]);
 
   const login = async (email: string, password: string) => {
-    const { data } = await api.post<{ token: string; id: number }>("/login", { email, password });
+    const { data } = await api.post<{ token: string; refresh_token: string; id: number }>("/login", { email, password });
     localStorage.setItem("token", data.token);
+    localStorage.setItem("refreshToken", data.refresh_token);
     setToken(data.token);
     try {
       // Try to load full user
@@ -113,7 +115,17 @@ export const AuthProvider: React.FC<React.PropsWithChildren> = ({ children }) =>
   };
 
   const logout = () => {
+    // Revoke server-side. Pass the header explicitly: the interceptor runs
+    // after the token has been removed below.
+    const current = localStorage.getItem("token");
+    const refreshToken = localStorage.getItem("refreshToken");
+    if (current) {
+      api.post("/logout", refreshToken ? { refresh_token: refreshToken } : null, {
+        headers: { Authorization: `Bearer ${current}` },
+      }).catch(() => { });
+    }
     localStorage.removeItem("token");
+    localStorage.removeItem("refreshToken");
     setToken(null);
     setUser(null);
   };
@@ -140,6 +152,7 @@ export const AuthProvider: React.FC<React.PropsWithChildren> = ({ children }) =>
         }
         // Other errors: treat as invalid session
         localStorage.removeItem("token");
+        localStorage.removeItem("refreshToken");
         setToken(null);
         setUser(null);
       }
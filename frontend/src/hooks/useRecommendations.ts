@@ -7,7 +7,10 @@ export type UseRecommendationsResult = {
   refreshing: boolean;              // true on manual refetch
   error: string | null;             // human-readable error, if any
   candidates: CandidateDisplay[];   // hydrated, ordered by backend score
-  refetch: () => void;              // manual reload
+  hasMore: boolean;                 // the feed continues after the loaded pages
+  loadingMore: boolean;             // true while the next page is fetched
+  loadMore: () => void;             // append the next page
+  refetch: () => void;              // manual reload (starts a new ranking)
 };
 
 /*
@@ -20,6 +23,8 @@ export default function useRecommendations(): UseRecommendationsResult {
   const [refreshing, setRefreshing] = useState(false);
   const [error, setError] = useState<string | null>(null);
   const [candidates, setCandidates] = useState<CandidateDisplay[]>([]);
+  const [nextCursor, setNextCursor] = useState<string | null>(null);
+  const [loadingMore, setLoadingMore] = useState(false);
 
   // Guards against stale setState when multiple loads overlap or component unmounts.
   const tick = useRef(0);
@@ -31,9 +36,10 @@ export default function useRecommendations(): UseRecommendationsResult {
     setError(null);
 
     try {
-      const list = await hydrateRecommendations();
+      const page = await hydrateRecommendations();
       if (myTick !== tick.current) return; // stale result, ignore
-      setCandidates(list);
+      setCandidates(page.items);
+      setNextCursor(page.nextCursor);
     } catch (err: unknown) {
       if (myTick !== tick.current) return;
       const error = err as { response?: { data?: { message?: string } }; message?: string };
@@ -61,5 +67,37 @@ export default function useRecommendations(): UseRecommendationsResult {
 
   const refetch = useCallback(() => load("refresh"), [load]);
 
-  return { loading, refreshing, error, candidates, refetch };
+  const loadMore = useCallback(async () => {
+    if (!nextCursor || loadingMore) return;
+    const myTick = tick.current;
+    setLoadingMore(true);
+    try {
+      const page = await hydrateRecommendations(nextCursor);
+      if (myTick !== tick.current) return;
+      setCandidates(prev => [...prev, ...page.items]);
+      setNextCursor(page.nextCursor);
+    } catch (err: unknown) {
+      if (myTick !== tick.current) return;
+      // An expired snapshot (410) means the ranking must start over
+      const error = err as { response?: { status?: number } };
+      if (error?.response?.status === 410) {
+        load("refresh");
+        return;
+      }
+      setError("Failed to load more recommendations.");
+    } finally {
+      if (myTick === tick.current) setLoadingMore(false);
+    }
+  }, [nextCursor, loadingMore, load]);
+
+  return {
+    loading,
+    refreshing,
+    error,
+    candidates,
+    hasMore: nextCursor !== null,
+    loadingMore,
+    loadMore,
+    refetch,
+  };
 }
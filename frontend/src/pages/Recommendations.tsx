@@ -14,7 +14,7 @@ import ProfileModal from "../components/ProfileModal";
 import ToastContainer from "../components/ToastContainer";
 
 const Recommendations: React.FC = () => {
-  const { loading, error, candidates } = useRecommendations();
+  const { loading, error, candidates, hasMore, loadingMore, loadMore } = useRecommendations();
   const { profile: myProfile } = useMyProfile();
   const { toasts, confirm, success } = useToast();
   const [selectedUserId, setSelectedUserId] = useState<number | null>(null);
@@ -145,6 +145,14 @@ const Recommendations: React.FC = () => {
         </div>
       )}
 
+      {hasMore && (
+        <div className={s.loadMore}>
+          <button className="u-btn" onClick={loadMore} disabled={loadingMore}>
+            {loadingMore ? "Loading…" : "Load more"}
+          </button>
+        </div>
+      )}
+
       {/* Floating Action Bar logic was tied to "currentCandidate" which implies a slideshow view? 
           But the main view renders a grid. 
           The code block had "selectedUserId !== null && currentCandidate" logic. 
@@ -1,8 +1,8 @@
 import { create } from 'zustand';
 import { openChatSocket } from '../api/ws';
 import { fetchChatHistory } from '../api/chat';
-import api from '../api/axios';
-import type { ChatMessage, ServerEvent } from '../types/chat';
+import api, { refreshAccessToken } from '../api/axios';
+import type { ChatMessage, PresenceUpdate, ServerEvent } from '../types/chat';
 
 const PAGE_SIZE = 50;
 
@@ -71,7 +71,8 @@ export const useChatStore = create<ChatStore>((set, get) => ({
         if (get().socket) return;
 
         // Optimistic queue handled internally by just checking readyState on send
-        const socket = openChatSocket(token);
+        // Prefer the stored token: it may have been refreshed since login
+        const socket = openChatSocket(localStorage.getItem("token") || token);
 
         // Handlers
         socket.onmessage = (evt) => {
@@ -130,21 +131,28 @@ export const useChatStore = create<ChatStore>((set, get) => ({
                     }
 
                 } else if (ev.type === "typing") {
+                    // Server sends explicit start/stop (and expires silent typers itself)
                     const from = ev.from;
+                    const typing = ev.data?.typing ?? false;
                     if (from != null) {
                         set(state => {
                             const c = state.convos[from] ?? { peerId: from, messages: [], peerTyping: false };
-                            return { convos: { ...state.convos, [from]: { ...c, peerTyping: true } } };
+                            return { convos: { ...state.convos, [from]: { ...c, peerTyping: typing } } };
                         });
-                        // Clear after delay
-                        setTimeout(() => {
-                            set(state => {
-                                const c = state.convos[from];
-                                if (!c) return {};
-                                return { convos: { ...state.convos, [from]: { ...c, peerTyping: false } } };
-                            });
-                        }, 1200);
                     }
+                } else if (ev.type === "reauth") {
+                    // Our access token expired: renew it and re-authenticate the socket
+                    refreshAccessToken().then(fresh => {
+                        if (fresh && socket.readyState === WebSocket.OPEN) {
+                            socket.send(JSON.stringify({ type: "auth", token: fresh }));
+                        }
+                    });
+                } else if (ev.type === "presence") {
+                    // Live sidebar status, pushed by the server on online/offline transitions
+                    const p = ev.data as PresenceUpdate;
+                    set(state => ({
+                        peers: state.peers.map(peer => peer.userId === p.user_id ? { ...peer, isOnline: p.is_online } : peer)
+                    }));
                 }
 
             } catch (e) {
@@ -305,7 +313,7 @@ export const useChatStore = create<ChatStore>((set, get) => ({
         // Let's implement debounce in component for now, or use a simple throttle here.
         const { socket, activePeer } = get();
         if (socket && activePeer) {
-            socket.send(JSON.stringify({ type: "typing", to: activePeer }));
+            socket.send(JSON.stringify({ type: "typing", to: activePeer, typing: true }));
         }
     },
 
@@ -17,7 +17,20 @@ export type ProfileOverview = {
 };
 
 export type RecommendationsResponse = {
-  recommendations: number[];   // max 10 ids, strongest-first
+  recommendations: number[];   // one page of ids, strongest-first
+  next_cursor: string | null;  // pass as ?cursor= for the next page; null at the end
+};
+
+// One scoring dimension of a match, scored from the viewer's side
+export type DimensionExplanation = {
+  dimension: string;
+  weight: number;
+  points: number;
+  shared?: string[];        // values both profiles list
+  groups?: string[];        // semantic groups, categories, cuisines or genres both hit
+  keywords?: string[];      // shared collaboration keywords
+  complementary?: string[]; // complementary roles, "teach/learn"
+  distance?: string;        // distance range, e.g. "5–15 km"
 };
 
 export type RecommendationsDetailedResponse = {
@@ -25,14 +38,42 @@ export type RecommendationsDetailedResponse = {
     user_id: number;
     score: number;
     score_percentage: number;
-    distance?: number;
+    distance?: string;       // distance range, e.g. "<5 km"
+    explanation: DimensionExplanation[];
   }[];
+  next_cursor: string | null;
+};
+
+export type RecommendationExplanation = {
+  user_id: number;
+  score: number;
+  score_percentage: number;
+  dimensions: DimensionExplanation[];
 };
 
 export type ConnectionsResponse = {
   connections: number[];       // accepted connections (ids)
 };
 
+export type BlocksResponse = {
+  blocked: number[];           // users I blocked (ids), most recent first
+};
+
+export type ReportRequest = {
+  user_id: number;
+  message_id?: number;         // report one of their messages to me instead of the profile
+  reason: string;              // at most 1000 characters
+};
+
+export type Warning = {
+  id: number;
+  user_id: number;
+  report_id?: number;
+  action: 'warn';
+  reason: string;
+  created_at: string;
+};
+
 export type UserBiography = {
     id: number;
     analog_passions: string[];
@@ -8,8 +8,16 @@ export type ChatMessage = {
   ts: string; // ISO
 };
 
+export type PresenceUpdate = {
+  user_id: number;
+  is_online: boolean;
+  last_online: string; // ISO
+};
+
 export type ServerEvent =
   | { type: "message"; from?: number; data?: ChatMessage }
-  | { type: "typing"; from?: number; data?: { typing: boolean } }
+  | { type: "typing"; from?: number; data?: { chat_id?: number; typing: boolean } }
+  | { type: "presence"; from?: number; data?: PresenceUpdate }
+  | { type: "reauth"; from?: number; data?: string }
   | { type: "info"; from?: number; data?: string }
   | { type: "error"; from?: number; data?: string };